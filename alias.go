@@ -39,15 +39,54 @@ type Point struct {
 }
 
 const (
-	ChartTypeLine   = "line"
-	ChartTypeBar    = "bar"
-	ChartTypePie    = "pie"
-	ChartTypeRadar  = "radar"
-	ChartTypeFunnel = "funnel"
+	ChartTypeLine    = "line"
+	ChartTypeBar     = "bar"
+	ChartTypePie     = "pie"
+	ChartTypeRadar   = "radar"
+	ChartTypeFunnel  = "funnel"
+	ChartTypeScatter = "scatter"
+	ChartTypeHeatmap = "heatmap"
+	ChartTypeGauge   = "gauge"
+	// candlestick
+	ChartTypeCandlestick = "candlestick"
+	// box plot
+	ChartTypeBoxPlot = "boxplot"
+	// sankey
+	ChartTypeSankey = "sankey"
+	// waterfall
+	ChartTypeWaterfall = "waterfall"
+	// gantt
+	ChartTypeGantt = "gantt"
+	// calendar
+	ChartTypeCalendar = "calendar"
+	// bullet
+	ChartTypeBullet = "bullet"
+	// radial bar
+	ChartTypeRadialBar = "radialBar"
+	// parallel
+	ChartTypeParallel = "parallel"
 	// horizontal bar
 	ChartTypeHorizontalBar = "horizontalBar"
 )
 
+const (
+	PieRoseTypeRadius = "radius"
+	PieRoseTypeArea   = "area"
+)
+
+const (
+	StepStart  = "start"
+	StepMiddle = "middle"
+	StepEnd    = "end"
+)
+
+const (
+	AxisTypeCategory = "category"
+	AxisTypeValue    = "value"
+	AxisTypeTime     = "time"
+	AxisTypeLog      = "log"
+)
+
 const (
 	ChartOutputSVG = "svg"
 	ChartOutputPNG = "png"
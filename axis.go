@@ -82,6 +82,9 @@ type AxisOption struct {
 	// The offset of label
 	LabelOffset Box
 	Unit        int
+	// The positions of ticks and labels, e.g.: the ticks of time axis.
+	// They are divided equally if it's empty
+	Positions []int
 }
 
 func (a *axisPainter) Render() (Box, error) {
@@ -142,6 +145,11 @@ func (a *axisPainter) Render() (Box, error) {
 		tickCount--
 		labelPosition = PositionLeft
 	}
+	// 指定位置的刻度与label一一对应
+	if len(opt.Positions) != 0 {
+		tickCount = len(opt.Positions)
+		labelPosition = PositionLeft
+	}
 	if isVertical && boundaryGap {
 		labelPosition = PositionCenter
 	}
@@ -178,10 +186,13 @@ func (a *axisPainter) Render() (Box, error) {
 	if unit <= 0 {
 
 		unit = ceilFloatToInt(float64(dataCount) / float64(fitTextCount))
-		unit = chart.MaxInt(unit, opt.SplitNumber)
-		// 偶数
-		if unit%2 == 0 && dataCount%(unit+1) == 0 {
-			unit++
+		// 指定位置的刻度已根据分隔数量生成
+		if len(opt.Positions) == 0 {
+			unit = chart.MaxInt(unit, opt.SplitNumber)
+			// 偶数
+			if unit%2 == 0 && dataCount%(unit+1) == 0 {
+				unit++
+			}
 		}
 	}
 
@@ -253,11 +264,12 @@ func (a *axisPainter) Render() (Box, error) {
 			Top:  ticksPaddingTop,
 			Left: ticksPaddingLeft,
 		})).Ticks(TicksOption{
-			Count:  tickCount,
-			Length: tickLength,
-			Unit:   unit,
-			Orient: orient,
-			First:  opt.FirstAxis,
+			Count:     tickCount,
+			Length:    tickLength,
+			Unit:      unit,
+			Orient:    orient,
+			First:     opt.FirstAxis,
+			Positions: opt.Positions,
 		})
 		p.LineStroke([]Point{
 			{
@@ -284,6 +296,7 @@ func (a *axisPainter) Render() (Box, error) {
 		Position:     labelPosition,
 		TextRotation: opt.TextRotation,
 		Offset:       opt.LabelOffset,
+		Positions:    opt.Positions,
 	})
 	// 显示辅助线
 	if opt.SplitLineShow {
@@ -314,8 +327,13 @@ func (a *axisPainter) Render() (Box, error) {
 		} else {
 			y0 := p.Height() - defaultXAxisHeight
 			y1 := top.Height() - defaultXAxisHeight
-			for index, x := range autoDivide(width, tickCount) {
-				if index == 0 {
+			xValues := opt.Positions
+			if len(xValues) == 0 {
+				xValues = autoDivide(width, tickCount)
+			}
+			for _, x := range xValues {
+				// 与y轴重叠的不展示
+				if x == 0 {
 					continue
 				}
 				top.LineStroke([]Point{
@@ -63,6 +63,9 @@ type BarChartOption struct {
 	// The legend option
 	Legend   LegendOption
 	BarWidth int
+	// The margin of bars in each category, default is decided by the width of category.
+	// Set it to 0 to draw contiguous bars, e.g. histogram
+	BarMargin *int
 }
 
 func (b *barChart) render(result *defaultRenderResult, seriesList SeriesList) (Box, error) {
@@ -70,11 +73,7 @@ func (b *barChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 	opt := b.opt
 	seriesPainter := result.seriesPainter
 
-	xRange := NewRange(AxisRangeOption{
-		Painter:     b.p,
-		DivideCount: len(opt.XAxis.Data),
-		Size:        seriesPainter.Width(),
-	})
+	xRange := newCategoryRange(b.p, len(opt.XAxis.Data), seriesPainter.Width())
 	x0, x1 := xRange.GetRange(0)
 	width := int(x1 - x0)
 	// 每一块之间的margin
@@ -88,7 +87,12 @@ func (b *barChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 		margin = 5
 		barMargin = 3
 	}
-	seriesCount := len(seriesList)
+	if opt.BarMargin != nil {
+		margin = *opt.BarMargin
+	}
+	// 同一堆叠的柱状图共用位置
+	stackIndexes, seriesCount := seriesList.getStackIndexes()
+	stackValues := seriesList.getStackValues()
 	// 总的宽度-两个margin-(总数-1)的barMargin
 	barWidth := (width - 2*margin - barMargin*(seriesCount-1)) / seriesCount
 	if opt.BarWidth > 0 && opt.BarWidth < barWidth {
@@ -106,6 +110,9 @@ func (b *barChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 		markPointPainter,
 		markLinePainter,
 	}
+	// 误差线在所有柱条之后绘制，避免被堆叠的柱条遮挡
+	errorBars := make([]Box, 0)
+	errorBarWidth := chart.MinInt(chart.MaxInt(barWidth>>1, 4), barWidth)
 	for index := range seriesList {
 		series := seriesList[index]
 		yRange := result.axisRanges[series.AxisIndex]
@@ -131,24 +138,40 @@ func (b *barChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 			}
 			x := divideValues[j]
 			x += margin
-			if index != 0 {
-				x += index * (barWidth + barMargin)
+			if stackIndexes[index] != 0 {
+				x += stackIndexes[index] * (barWidth + barMargin)
+			}
+			right := x + barWidth
+			// 无间隔时以下一分类的起始位置为结束，避免取整产生空隙
+			if margin == 0 && seriesCount == 1 {
+				right = divideValues[j+1]
 			}
 
-			h := int(yRange.getHeight(item.Value))
+			value := stackValues.values[index][j]
+			baseValue := stackValues.baseValues[index][j]
+			h := int(yRange.getHeight(value + baseValue))
 			fillColor := seriesColor
 			if !item.Style.FillColor.IsZero() {
 				fillColor = item.Style.FillColor
 			}
 			top := barMaxHeight - h
+			bottom := barMaxHeight - 1
+			// 堆叠的柱状图从前一系列的顶部开始
+			if baseValue != 0 {
+				bottom = barMaxHeight - int(yRange.getHeight(baseValue))
+				// 负值向下堆叠
+				if top > bottom {
+					top, bottom = bottom, top
+				}
+			}
 
 			seriesPainter.OverrideDrawingStyle(Style{
 				FillColor: fillColor,
 			}).Rect(chart.Box{
 				Top:    top,
 				Left:   x,
-				Right:  x + barWidth,
-				Bottom: barMaxHeight - 1,
+				Right:  right,
+				Bottom: bottom,
 			})
 			// 用于生成marker point
 			points[j] = Point{
@@ -156,21 +179,28 @@ func (b *barChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 				X: x + barWidth>>1,
 				Y: top,
 			}
-			// 用于生成marker point
-			points[j] = Point{
-				// 居中的位置
-				X: x + barWidth>>1,
-				Y: top,
+			// 百分比堆叠不展示误差线
+			if lower, upper, ok := getSeriesDataBounds(item); ok && !stackValues.percents[index] {
+				center := x + barWidth>>1
+				errorBars = append(errorBars, Box{
+					Top:    barMaxHeight - int(yRange.getHeight(upper+baseValue)),
+					Left:   center - errorBarWidth>>1,
+					Right:  center - errorBarWidth>>1 + errorBarWidth,
+					Bottom: barMaxHeight - int(yRange.getHeight(lower+baseValue)),
+				})
 			}
 			// 如果label不需要展示，则返回
 			if labelPainter == nil {
 				continue
 			}
-			y := barMaxHeight - h
+			y := top
 			radians := float64(0)
 			fontColor := series.Label.Color
 			if series.Label.Position == PositionBottom {
 				y = barMaxHeight
+				if baseValue != 0 {
+					y = bottom
+				}
 				radians = -math.Pi / 2
 				if fontColor.IsZero() {
 					if isLightColor(fillColor) {
@@ -190,6 +220,7 @@ func (b *barChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 				FontColor: fontColor,
 				Offset:    series.Label.Offset,
 				FontSize:  series.Label.FontSize,
+				Percent:   stackValues.getPercent(index, j),
 			})
 		}
 
@@ -209,6 +240,45 @@ func (b *barChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 		})
 	}
 	// 最大、最小的mark point
+	if len(errorBars) != 0 {
+		seriesPainter.OverrideDrawingStyle(Style{
+			StrokeWidth: 1,
+			StrokeColor: theme.GetTextColor(),
+		})
+		for _, errorBar := range errorBars {
+			center := (errorBar.Left + errorBar.Right) >> 1
+			seriesPainter.LineStroke([]Point{
+				{
+					X: center,
+					Y: errorBar.Top,
+				},
+				{
+					X: center,
+					Y: errorBar.Bottom,
+				},
+			})
+			seriesPainter.LineStroke([]Point{
+				{
+					X: errorBar.Left,
+					Y: errorBar.Top,
+				},
+				{
+					X: errorBar.Right,
+					Y: errorBar.Top,
+				},
+			})
+			seriesPainter.LineStroke([]Point{
+				{
+					X: errorBar.Left,
+					Y: errorBar.Bottom,
+				},
+				{
+					X: errorBar.Right,
+					Y: errorBar.Bottom,
+				},
+			})
+		}
+	}
 	err := doRender(rendererList...)
 	if err != nil {
 		return BoxZero, err
@@ -0,0 +1,340 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"math"
+	"sort"
+
+	"github.com/golang/freetype/truetype"
+)
+
+type boxPlotChart struct {
+	p   *Painter
+	opt *BoxPlotChartOption
+}
+
+// boxPlotWhiskerIQR is the range of whisker, the samples out of
+// [Q1 - 1.5 * IQR, Q3 + 1.5 * IQR] are outliers
+const boxPlotWhiskerIQR = 1.5
+
+// NewBoxPlotSeriesList returns a series list for box plot chart,
+// the value of each point is [lowest, Q1, median, Q3, highest]
+func NewBoxPlotSeriesList(values [][][]float64) SeriesList {
+	seriesList := make(SeriesList, len(values))
+	for index, points := range values {
+		data := make([]SeriesData, len(points))
+		for j, value := range points {
+			data[j] = newBoxPlotSeriesData(value)
+		}
+		seriesList[index] = Series{
+			Type: ChartTypeBoxPlot,
+			Data: data,
+		}
+	}
+	return seriesList
+}
+
+// NewBoxPlotSeriesListFromSamples returns a series list for box plot chart,
+// the quartiles, whiskers and outliers of each point are computed from the samples
+func NewBoxPlotSeriesListFromSamples(samples [][][]float64) SeriesList {
+	seriesList := make(SeriesList, len(samples))
+	for index, points := range samples {
+		data := make([]SeriesData, len(points))
+		for j, values := range points {
+			data[j] = NewBoxPlotSeriesData(values)
+		}
+		seriesList[index] = Series{
+			Type: ChartTypeBoxPlot,
+			Data: data,
+		}
+	}
+	return seriesList
+}
+
+// BoxPlotData is the data of box plot
+type BoxPlotData struct {
+	// The lower whisker
+	Low float64
+	// The first quartile
+	Q1 float64
+	// The median
+	Median float64
+	// The third quartile
+	Q3 float64
+	// The upper whisker
+	High float64
+	// The outliers
+	Outliers []float64
+}
+
+func newBoxPlotSeriesData(values []float64) SeriesData {
+	if len(values) < 5 {
+		return SeriesData{
+			Value: nullValue,
+		}
+	}
+	return SeriesData{
+		// 以中位数作为数据值
+		Value: values[2],
+		BoxPlot: &BoxPlotData{
+			Low:    values[0],
+			Q1:     values[1],
+			Median: values[2],
+			Q3:     values[3],
+			High:   values[4],
+		},
+	}
+}
+
+// NewBoxPlotSeriesData returns the series data of box plot from samples,
+// the whiskers extend to the most extreme samples within 1.5 IQR of the box
+// and the others are outliers
+func NewBoxPlotSeriesData(samples []float64) SeriesData {
+	if len(samples) == 0 {
+		return SeriesData{
+			Value: nullValue,
+		}
+	}
+	q1, median, q3 := Quartiles(samples)
+	iqr := q3 - q1
+	lowerBound := q1 - boxPlotWhiskerIQR*iqr
+	upperBound := q3 + boxPlotWhiskerIQR*iqr
+	low := math.MaxFloat64
+	high := -math.MaxFloat64
+	var outliers []float64
+	for _, v := range samples {
+		if v < lowerBound || v > upperBound {
+			outliers = append(outliers, v)
+			continue
+		}
+		low = math.Min(low, v)
+		high = math.Max(high, v)
+	}
+	return SeriesData{
+		Value: median,
+		BoxPlot: &BoxPlotData{
+			Low:      low,
+			Q1:       q1,
+			Median:   median,
+			Q3:       q3,
+			High:     high,
+			Outliers: outliers,
+		},
+	}
+}
+
+// Quartiles returns the first quartile, median and third quartile of samples,
+// it uses linear interpolation between the closest ranks.
+// NaN is returned if the samples is empty.
+func Quartiles(samples []float64) (float64, float64, float64) {
+	if len(samples) == 0 {
+		return math.NaN(), math.NaN(), math.NaN()
+	}
+	values := make([]float64, len(samples))
+	copy(values, samples)
+	sort.Float64s(values)
+	return quantile(values, 0.25), quantile(values, 0.5), quantile(values, 0.75)
+}
+
+// quantile returns the p quantile of sorted values
+func quantile(sortedValues []float64, p float64) float64 {
+	h := float64(len(sortedValues)-1) * p
+	index := int(math.Floor(h))
+	if index+1 >= len(sortedValues) {
+		return sortedValues[index]
+	}
+	return sortedValues[index] + (h-float64(index))*(sortedValues[index+1]-sortedValues[index])
+}
+
+// NewBoxPlotChart returns a box plot chart renderer
+func NewBoxPlotChart(p *Painter, opt BoxPlotChartOption) *boxPlotChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &boxPlotChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+type BoxPlotChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The data series list
+	SeriesList SeriesList
+	// The x axis option
+	XAxis XAxisOption
+	// The padding of box plot chart
+	Padding Box
+	// The y axis option
+	YAxisOptions []YAxisOption
+	// The option of title
+	Title TitleOption
+	// The legend option
+	Legend LegendOption
+	// The width of box
+	BarWidth int
+}
+
+func (b *boxPlotChart) render(result *defaultRenderResult, seriesList SeriesList) (Box, error) {
+	p := b.p
+	opt := b.opt
+	seriesPainter := result.seriesPainter
+
+	xRange := newCategoryRange(b.p, len(opt.XAxis.Data), seriesPainter.Width())
+	x0, x1 := xRange.GetRange(0)
+	width := int(x1 - x0)
+	// 每一块之间的margin
+	margin := 10
+	// 每一个box之间的margin
+	barMargin := 5
+	if width < 20 {
+		margin = 2
+		barMargin = 2
+	} else if width < 50 {
+		margin = 5
+		barMargin = 3
+	}
+	seriesCount := len(seriesList)
+	barWidth := (width - 2*margin - barMargin*(seriesCount-1)) / seriesCount
+	if opt.BarWidth > 0 && opt.BarWidth < barWidth {
+		barWidth = opt.BarWidth
+		// 重新计算margin
+		margin = (width - seriesCount*barWidth - barMargin*(seriesCount-1)) / 2
+	}
+	if barWidth < 1 {
+		barWidth = 1
+	}
+	theme := opt.Theme
+	divideValues := xRange.AutoDivide()
+
+	for index := range seriesList {
+		series := seriesList[index]
+		yRange := result.axisRanges[series.AxisIndex]
+		seriesColor := theme.GetSeriesColor(series.index)
+
+		for j, item := range series.Data {
+			if j >= xRange.divideCount || item.Value == nullValue || item.BoxPlot == nil {
+				continue
+			}
+			boxPlot := item.BoxPlot
+			x := divideValues[j] + margin
+			if index != 0 {
+				x += index * (barWidth + barMargin)
+			}
+			center := x + barWidth>>1
+			color := seriesColor
+			if !item.Style.StrokeColor.IsZero() {
+				color = item.Style.StrokeColor
+			}
+			fillColor := color.WithAlpha(60)
+			if !item.Style.FillColor.IsZero() {
+				fillColor = item.Style.FillColor
+			}
+
+			lowY := yRange.getRestHeight(boxPlot.Low)
+			q1Y := yRange.getRestHeight(boxPlot.Q1)
+			medianY := yRange.getRestHeight(boxPlot.Median)
+			q3Y := yRange.getRestHeight(boxPlot.Q3)
+			highY := yRange.getRestHeight(boxPlot.High)
+			whiskerLeft := center - barWidth>>2
+			whiskerRight := center + barWidth>>2
+
+			seriesPainter.OverrideDrawingStyle(Style{
+				StrokeColor: color,
+				StrokeWidth: 1,
+			})
+			// 须线，以math.MaxInt32分隔线段
+			seriesPainter.LineStroke([]Point{
+				{X: center, Y: highY},
+				{X: center, Y: q3Y},
+				{X: math.MaxInt32, Y: math.MaxInt32},
+				{X: center, Y: q1Y},
+				{X: center, Y: lowY},
+				{X: math.MaxInt32, Y: math.MaxInt32},
+				{X: whiskerLeft, Y: highY},
+				{X: whiskerRight, Y: highY},
+				{X: math.MaxInt32, Y: math.MaxInt32},
+				{X: whiskerLeft, Y: lowY},
+				{X: whiskerRight, Y: lowY},
+			})
+			// 箱体
+			seriesPainter.OverrideDrawingStyle(Style{
+				StrokeColor: color,
+				StrokeWidth: 1,
+				FillColor:   fillColor,
+			})
+			seriesPainter.MoveTo(x, q3Y).
+				LineTo(x+barWidth, q3Y).
+				LineTo(x+barWidth, q1Y).
+				LineTo(x, q1Y).
+				Close().
+				FillStroke()
+			// 中位线
+			seriesPainter.OverrideDrawingStyle(Style{
+				StrokeColor: color,
+				StrokeWidth: 2,
+			}).LineStroke([]Point{
+				{X: x, Y: medianY},
+				{X: x + barWidth, Y: medianY},
+			})
+			// 异常值
+			if len(boxPlot.Outliers) == 0 {
+				continue
+			}
+			seriesPainter.OverrideDrawingStyle(Style{
+				StrokeColor: color,
+				StrokeWidth: 1,
+				FillColor:   theme.GetBackgroundColor(),
+			})
+			for _, v := range boxPlot.Outliers {
+				seriesPainter.Circle(3, center, yRange.getRestHeight(v))
+			}
+			seriesPainter.FillStroke()
+		}
+	}
+
+	return p.box, nil
+}
+
+func (b *boxPlotChart) Render() (Box, error) {
+	p := b.p
+	opt := b.opt
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		Theme:        opt.Theme,
+		Padding:      opt.Padding,
+		SeriesList:   opt.SeriesList,
+		XAxis:        opt.XAxis,
+		YAxisOptions: opt.YAxisOptions,
+		TitleOption:  opt.Title,
+		LegendOption: opt.Legend,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	seriesList := opt.SeriesList.Filter(ChartTypeBoxPlot)
+	return b.render(renderResult, seriesList)
+}
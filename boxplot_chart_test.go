@@ -0,0 +1,200 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuartiles(t *testing.T) {
+	assert := assert.New(t)
+
+	q1, median, q3 := Quartiles([]float64{
+		7,
+		1,
+		3,
+		5,
+	})
+	assert.Equal(2.5, q1)
+	assert.Equal(4.0, median)
+	assert.Equal(5.5, q3)
+
+	q1, median, q3 = Quartiles([]float64{
+		1,
+		2,
+		3,
+		4,
+		5,
+	})
+	assert.Equal(2.0, q1)
+	assert.Equal(3.0, median)
+	assert.Equal(4.0, q3)
+
+	q1, median, q3 = Quartiles([]float64{
+		10,
+	})
+	assert.Equal(10.0, q1)
+	assert.Equal(10.0, median)
+	assert.Equal(10.0, q3)
+
+	q1, _, _ = Quartiles(nil)
+	assert.True(math.IsNaN(q1))
+}
+
+func TestNewBoxPlotSeriesData(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(SeriesData{
+		Value: 3,
+		BoxPlot: &BoxPlotData{
+			Low:    1,
+			Q1:     2,
+			Median: 3,
+			Q3:     4,
+			High:   5,
+			Outliers: []float64{
+				20,
+			},
+		},
+	}, NewBoxPlotSeriesData([]float64{
+		1,
+		2,
+		3,
+		4,
+		5,
+		20,
+		3,
+		2,
+		4,
+	}))
+
+	assert.Equal(nullValue, NewBoxPlotSeriesData(nil).Value)
+	assert.Equal(nullValue, newBoxPlotSeriesData([]float64{1, 2}).Value)
+}
+
+func TestBoxPlotChart(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		render func(*Painter) ([]byte, error)
+		result string
+	}{
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewBoxPlotChart(p, BoxPlotChartOption{
+					Title: TitleOption{
+						Text: "Latency",
+					},
+					Padding: Box{
+						Left:   10,
+						Top:    10,
+						Right:  10,
+						Bottom: 10,
+					},
+					XAxis: NewXAxisOption([]string{
+						"A",
+						"B",
+						"C",
+					}),
+					SeriesList: NewBoxPlotSeriesList([][][]float64{
+						{
+							{
+								20, 40, 50, 60, 90,
+							},
+							{
+								30, 55, 70, 80, 110,
+							},
+							{
+								10, 35, 40, 48, 70,
+							},
+						},
+					}),
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"10\" y=\"25\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Latency</text><text x=\"10\" y=\"52\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"10\" y=\"104\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><text x=\"19\" y=\"157\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">80</text><text x=\"19\" y=\"209\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">60</text><text x=\"19\" y=\"262\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"19\" y=\"314\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"28\" y=\"367\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path  d=\"M 47 45\nL 590 45\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 97\nL 590 97\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 150\nL 590 150\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 202\nL 590 202\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 255\nL 590 255\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 307\nL 590 307\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 365\nL 47 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 228 365\nL 228 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 409 365\nL 409 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 590 365\nL 590 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 47 360\nL 590 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"132\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"313\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"494\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><path  d=\"M 137 124\nL 137 203\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 137 255\nL 137 308\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 97 124\nL 177 124\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 97 308\nL 177 308\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 57 203\nL 218 203\nL 218 255\nL 57 255\nZ\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,0.2)\"/><path  d=\"M 57 229\nL 218 229\" style=\"stroke-width:2;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 318 72\nL 318 150\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 318 216\nL 318 282\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 278 72\nL 358 72\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 278 282\nL 358 282\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 238 150\nL 399 150\nL 399 216\nL 238 216\nZ\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,0.2)\"/><path  d=\"M 238 177\nL 399 177\" style=\"stroke-width:2;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 499 177\nL 499 234\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 499 269\nL 499 334\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 459 177\nL 539 177\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 459 334\nL 539 334\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 419 234\nL 580 234\nL 580 269\nL 419 269\nZ\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,0.2)\"/><path  d=\"M 419 255\nL 580 255\" style=\"stroke-width:2;stroke:rgba(84,112,198,1.0);fill:none\"/></svg>",
+		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewBoxPlotChart(p, BoxPlotChartOption{
+					Padding: Box{
+						Left:   10,
+						Top:    10,
+						Right:  10,
+						Bottom: 10,
+					},
+					XAxis: NewXAxisOption([]string{
+						"v1",
+						"v2",
+					}),
+					Legend: NewLegendOption([]string{
+						"us",
+						"eu",
+					}),
+					BarWidth: 30,
+					SeriesList: NewBoxPlotSeriesListFromSamples([][][]float64{
+						{
+							{
+								12, 15, 18, 20, 22, 25, 27, 60,
+							},
+							{
+								30, 32, 35, 38, 40, 42, 45, 5,
+							},
+						},
+						{
+							{
+								20, 22, 24, 26, 28,
+							},
+							{
+								35, 36, 40, 44, 46,
+							},
+						},
+					}),
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 242 19\nL 272 19\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><circle cx=\"257\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"274\" y=\"25\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">us</text><path  d=\"M 311 19\nL 341 19\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><circle cx=\"326\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"343\" y=\"25\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">eu</text><text x=\"10\" y=\"17\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">60</text><text x=\"10\" y=\"75\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">50</text><text x=\"10\" y=\"133\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"10\" y=\"192\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">30</text><text x=\"10\" y=\"250\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"10\" y=\"308\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">10</text><text x=\"19\" y=\"367\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path  d=\"M 38 10\nL 590 10\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 38 68\nL 590 68\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 38 126\nL 590 126\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 38 185\nL 590 185\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 38 243\nL 590 243\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 38 301\nL 590 301\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 38 365\nL 38 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 314 365\nL 314 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 590 365\nL 590 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 38 360\nL 590 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"168\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">v1</text><text x=\"444\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">v2</text><path  d=\"M 158 203\nL 158 212\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 158 260\nL 158 290\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 151 203\nL 165 203\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 151 290\nL 165 290\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 143 212\nL 173 212\nL 173 260\nL 143 260\nZ\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,0.2)\"/><path  d=\"M 143 238\nL 173 238\" style=\"stroke-width:2;stroke:rgba(84,112,198,1.0);fill:none\"/><circle cx=\"158\" cy=\"10\" r=\"3\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"M 434 98\nL 434 124\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 434 177\nL 434 185\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 427 98\nL 441 98\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 427 185\nL 441 185\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 419 124\nL 449 124\nL 449 177\nL 419 177\nZ\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,0.2)\"/><path  d=\"M 419 148\nL 449 148\" style=\"stroke-width:2;stroke:rgba(84,112,198,1.0);fill:none\"/><circle cx=\"434\" cy=\"331\" r=\"3\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"M 193 197\nL 193 209\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:none\"/><path  d=\"M 193 232\nL 193 244\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:none\"/><path  d=\"M 186 197\nL 200 197\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:none\"/><path  d=\"M 186 244\nL 200 244\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:none\"/><path  d=\"M 178 209\nL 208 209\nL 208 232\nL 178 232\nZ\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,0.2)\"/><path  d=\"M 178 220\nL 208 220\" style=\"stroke-width:2;stroke:rgba(145,204,117,1.0);fill:none\"/><path  d=\"M 469 92\nL 469 104\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:none\"/><path  d=\"M 469 150\nL 469 156\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:none\"/><path  d=\"M 462 92\nL 476 92\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:none\"/><path  d=\"M 462 156\nL 476 156\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:none\"/><path  d=\"M 454 104\nL 484 104\nL 484 150\nL 454 150\nZ\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,0.2)\"/><path  d=\"M 454 127\nL 484 127\" style=\"stroke-width:2;stroke:rgba(145,204,117,1.0);fill:none\"/></svg>",
+		},
+	}
+
+	for _, tt := range tests {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		data, err := tt.render(p)
+		assert.Nil(err)
+		assert.Equal(tt.result, string(data))
+	}
+}
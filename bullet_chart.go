@@ -0,0 +1,317 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"math"
+	"sort"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+type bulletChart struct {
+	p   *Painter
+	opt *BulletChartOption
+}
+
+// BulletData is the data of bullet chart
+type BulletData struct {
+	// The target, it's drawn as a marker
+	Target *float64
+	// The qualitative ranges, each value is the end of a range
+	Ranges []float64
+}
+
+// NewBulletSeriesList returns a series list for bullet chart,
+// each category has a value, a target and the qualitative ranges
+func NewBulletSeriesList(values, targets []float64, ranges [][]float64) SeriesList {
+	series := NewSeriesFromValues(values, ChartTypeBullet)
+	for index := range series.Data {
+		bullet := BulletData{}
+		if index < len(targets) && targets[index] != nullValue {
+			bullet.Target = NewFloatPoint(targets[index])
+		}
+		if index < len(ranges) {
+			bullet.Ranges = ranges[index]
+		}
+		if bullet.Target != nil || len(bullet.Ranges) != 0 {
+			series.Data[index].Bullet = &bullet
+		}
+	}
+	return SeriesList{
+		series,
+	}
+}
+
+// NewBulletChart returns a bullet chart renderer
+func NewBulletChart(p *Painter, opt BulletChartOption) *bulletChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &bulletChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+type BulletOption struct {
+	// The orient of bullet chart, "horizontal" or "vertical", default is horizontal
+	Orient string
+}
+
+type BulletChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The data series list
+	SeriesList SeriesList
+	// The x axis option, the data is the category list of vertical bullet chart
+	XAxis XAxisOption
+	// The padding of bullet chart
+	Padding Box
+	// The y axis option, the data of first y axis is the category list of horizontal bullet chart
+	YAxisOptions []YAxisOption
+	// The option of title
+	Title TitleOption
+	// The legend option
+	Legend LegendOption
+	// The option of bullet
+	Bullet BulletOption
+	// The width of qualitative ranges of vertical bullet chart, default is 60% of category
+	BarWidth int
+	// The height of qualitative ranges of horizontal bullet chart, default is 60% of category
+	BarHeight int
+}
+
+func (opt *BulletOption) isVertical() bool {
+	return opt.Orient == OrientVertical
+}
+
+// getBulletRangeColors returns the colors of qualitative ranges, from dark to light
+func getBulletRangeColors(theme ColorPalette, count int) []Color {
+	colors := make([]Color, count)
+	scale := []Color{
+		theme.GetBackgroundColor(),
+		theme.GetTextColor(),
+	}
+	for index := range colors {
+		percent := 0.3
+		if count > 1 {
+			percent = 0.35 - 0.25*float64(index)/float64(count-1)
+		}
+		colors[index] = interpolateColor(scale, percent)
+	}
+	return colors
+}
+
+func (b *bulletChart) render(result *defaultRenderResult, seriesList SeriesList) (Box, error) {
+	p := b.p
+	opt := b.opt
+	theme := opt.Theme
+	seriesPainter := result.seriesPainter
+	isVertical := opt.Bullet.isVertical()
+
+	var categoryCount int
+	var categorySize int
+	var valueRange axisRange
+	if isVertical {
+		categoryCount = len(opt.XAxis.Data)
+		categorySize = seriesPainter.Width()
+		valueRange = result.axisRanges[0]
+	} else {
+		categoryCount = result.axisRanges[0].divideCount
+		categorySize = seriesPainter.Height()
+		valueRange = result.xAxisRange
+	}
+	if categoryCount <= 0 || len(seriesList) == 0 {
+		return p.box, nil
+	}
+	// 值对应的位置，竖向时由下至上
+	getValuePosition := func(value float64) int {
+		if isVertical {
+			return valueRange.getRestHeight(value)
+		}
+		return valueRange.getHeight(value)
+	}
+	zeroPosition := getValuePosition(math.Max(valueRange.min, 0))
+	// 转换为绘制区域，start与end为值轴上的位置
+	getBox := func(start, end, categoryStart, categoryEnd int) Box {
+		if start > end {
+			start, end = end, start
+		}
+		if isVertical {
+			return Box{
+				Top:    start,
+				Left:   categoryStart,
+				Right:  categoryEnd,
+				Bottom: end,
+			}
+		}
+		return Box{
+			Top:    categoryStart,
+			Left:   start,
+			Right:  end,
+			Bottom: categoryEnd,
+		}
+	}
+
+	divideValues := autoDivide(categorySize, categoryCount)
+	slotSize := (divideValues[1] - divideValues[0]) / len(seriesList)
+	rangeWidth := slotSize * 3 / 5
+	customWidth := opt.BarHeight
+	if isVertical {
+		customWidth = opt.BarWidth
+	}
+	if customWidth > 0 && customWidth < slotSize {
+		rangeWidth = customWidth
+	}
+	barWidth := chart.MaxInt(rangeWidth/3, 1)
+	targetWidth := rangeWidth * 3 / 4
+	textColor := theme.GetTextColor()
+	seriesNames := seriesList.Names()
+
+	rendererList := []Renderer{}
+	for index := range seriesList {
+		series := seriesList[index]
+		seriesColor := theme.GetSeriesColor(series.index)
+		var labelPainter *SeriesLabelPainter
+		if series.Label.Show {
+			labelPainter = NewSeriesLabelPainter(SeriesLabelPainterParams{
+				P:           seriesPainter,
+				SeriesNames: seriesNames,
+				Label:       series.Label,
+				Theme:       opt.Theme,
+				Font:        opt.Font,
+			})
+			rendererList = append(rendererList, labelPainter)
+		}
+		for j, item := range series.Data {
+			if j >= categoryCount {
+				continue
+			}
+			// 与横向柱状图一致，第一个分类在最下方
+			row := j
+			if !isVertical {
+				row = categoryCount - j - 1
+			}
+			center := divideValues[row] + slotSize*index + slotSize>>1
+
+			// 区间由大至小绘制，避免被覆盖
+			bullet := BulletData{}
+			if item.Bullet != nil {
+				bullet = *item.Bullet
+			}
+			ranges := append([]float64{}, bullet.Ranges...)
+			sort.Float64s(ranges)
+			rangeColors := getBulletRangeColors(theme, len(ranges))
+			for k := len(ranges) - 1; k >= 0; k-- {
+				seriesPainter.OverrideDrawingStyle(Style{
+					FillColor: rangeColors[k],
+				}).Rect(getBox(zeroPosition, getValuePosition(ranges[k]), center-rangeWidth>>1, center-rangeWidth>>1+rangeWidth))
+			}
+
+			if item.Value != nullValue {
+				fillColor := seriesColor
+				if !item.Style.FillColor.IsZero() {
+					fillColor = item.Style.FillColor
+				}
+				end := getValuePosition(item.Value)
+				// 至少展示1px
+				if end == zeroPosition {
+					end++
+				}
+				seriesPainter.OverrideDrawingStyle(Style{
+					FillColor: fillColor,
+				}).Rect(getBox(zeroPosition, end, center-barWidth>>1, center-barWidth>>1+barWidth))
+
+				if labelPainter != nil {
+					labelValue := LabelValue{
+						Index:     index,
+						Value:     item.Value,
+						X:         center,
+						Y:         end,
+						Offset:    series.Label.Offset,
+						FontColor: series.Label.Color,
+						FontSize:  series.Label.FontSize,
+					}
+					if !isVertical {
+						labelValue.Orient = OrientHorizontal
+						labelValue.X = end
+						labelValue.Y = center
+					}
+					labelPainter.Add(labelValue)
+				}
+			}
+
+			if bullet.Target != nil {
+				position := getValuePosition(*bullet.Target)
+				seriesPainter.OverrideDrawingStyle(Style{
+					FillColor: textColor,
+				}).Rect(getBox(position-1, position+2, center-targetWidth>>1, center-targetWidth>>1+targetWidth))
+			}
+		}
+	}
+	err := doRender(rendererList...)
+	if err != nil {
+		return BoxZero, err
+	}
+	return p.box, nil
+}
+
+// bulletRenderOption returns the render option of bullet chart, the horizontal
+// bullet chart uses the layout of horizontal bar chart
+func bulletRenderOption(opt defaultRenderOption, bullet BulletOption) defaultRenderOption {
+	if bullet.isVertical() {
+		return opt
+	}
+	opt.axisReversed = true
+	yAxisOptions := append([]YAxisOption{}, opt.YAxisOptions...)
+	if len(yAxisOptions) == 0 {
+		yAxisOptions = append(yAxisOptions, YAxisOption{})
+	}
+	yAxisOptions[0].DivideCount = len(yAxisOptions[0].Data)
+	yAxisOptions[0].Unit = 1
+	opt.YAxisOptions = yAxisOptions
+	return opt
+}
+
+func (b *bulletChart) Render() (Box, error) {
+	p := b.p
+	opt := b.opt
+	renderResult, err := defaultRender(p, bulletRenderOption(defaultRenderOption{
+		Theme:        opt.Theme,
+		Padding:      opt.Padding,
+		SeriesList:   opt.SeriesList,
+		XAxis:        opt.XAxis,
+		YAxisOptions: opt.YAxisOptions,
+		TitleOption:  opt.Title,
+		LegendOption: opt.Legend,
+	}, opt.Bullet))
+	if err != nil {
+		return BoxZero, err
+	}
+	seriesList := opt.SeriesList.Filter(ChartTypeBullet)
+	return b.render(renderResult, seriesList)
+}
@@ -0,0 +1,194 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBulletSeriesList(t *testing.T) {
+	assert := assert.New(t)
+
+	seriesList := NewBulletSeriesList([]float64{
+		270,
+		23,
+	}, []float64{
+		nullValue,
+		26,
+	}, [][]float64{
+		{
+			150,
+			300,
+		},
+	})
+	assert.Equal(SeriesList{
+		{
+			Type: ChartTypeBullet,
+			Data: []SeriesData{
+				{
+					Value: 270,
+					Bullet: &BulletData{
+						Ranges: []float64{
+							150,
+							300,
+						},
+					},
+				},
+				{
+					Value: 23,
+					Bullet: &BulletData{
+						Target: NewFloatPoint(26),
+					},
+				},
+			},
+		},
+	}, seriesList)
+
+	max, min := seriesList.GetMaxMin(0)
+	assert.Equal(300.0, max)
+	assert.Equal(23.0, min)
+	assert.True(seriesList.hasBar(0))
+}
+
+func TestGetBulletRangeColors(t *testing.T) {
+	assert := assert.New(t)
+
+	colors := getBulletRangeColors(defaultTheme, 3)
+	assert.Equal(3, len(colors))
+	// 由深至浅
+	assert.True(colors[0].R < colors[1].R)
+	assert.True(colors[1].R < colors[2].R)
+}
+
+func TestBulletChart(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		render func(*Painter) ([]byte, error)
+		result string
+	}{
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewBulletChart(p, BulletChartOption{
+					Title: TitleOption{
+						Text: "KPI",
+					},
+					Padding: Box{
+						Top:    10,
+						Right:  10,
+						Bottom: 10,
+						Left:   10,
+					},
+					SeriesList: NewBulletSeriesList([]float64{
+						220,
+						180,
+					}, []float64{
+						250,
+						200,
+					}, [][]float64{
+						{
+							150,
+							225,
+							300,
+						},
+						{
+							120,
+							200,
+							300,
+						},
+					}),
+					YAxisOptions: []YAxisOption{
+						{
+							Data: []string{
+								"Revenue",
+								"Profit",
+							},
+						},
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"10\" y=\"25\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">KPI</text><path  d=\"M 74 45\nL 79 45\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 74 202\nL 79 202\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 74 360\nL 79 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 79 45\nL 79 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"30\" y=\"130\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Profit</text><text x=\"10\" y=\"288\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Revenue</text><text x=\"75\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"155\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">50</text><text x=\"236\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><text x=\"321\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">150</text><text x=\"406\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">200</text><text x=\"491\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">250</text><text x=\"577\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">300</text><path  d=\"M 164 45\nL 164 360\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 249 45\nL 249 360\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 334 45\nL 334 360\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 419 45\nL 419 360\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 504 45\nL 504 360\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 590 45\nL 590 360\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 79 233\nL 590 233\nL 590 327\nL 79 327\nL 79 233\" style=\"stroke-width:0;stroke:none;fill:rgba(237,237,237,1.0)\"/><path  d=\"M 79 233\nL 462 233\nL 462 327\nL 79 327\nL 79 233\" style=\"stroke-width:0;stroke:none;fill:rgba(213,213,213,1.0)\"/><path  d=\"M 79 233\nL 334 233\nL 334 327\nL 79 327\nL 79 233\" style=\"stroke-width:0;stroke:none;fill:rgba(190,190,190,1.0)\"/><path  d=\"M 79 265\nL 453 265\nL 453 296\nL 79 296\nL 79 265\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 503 245\nL 506 245\nL 506 315\nL 503 315\nL 503 245\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0)\"/><path  d=\"M 79 76\nL 590 76\nL 590 170\nL 79 170\nL 79 76\" style=\"stroke-width:0;stroke:none;fill:rgba(237,237,237,1.0)\"/><path  d=\"M 79 76\nL 419 76\nL 419 170\nL 79 170\nL 79 76\" style=\"stroke-width:0;stroke:none;fill:rgba(213,213,213,1.0)\"/><path  d=\"M 79 76\nL 283 76\nL 283 170\nL 79 170\nL 79 76\" style=\"stroke-width:0;stroke:none;fill:rgba(190,190,190,1.0)\"/><path  d=\"M 79 108\nL 385 108\nL 385 139\nL 79 139\nL 79 108\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 418 88\nL 421 88\nL 421 158\nL 418 158\nL 418 88\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0)\"/></svg>",
+		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				seriesList := NewBulletSeriesList([]float64{
+					23,
+					8,
+				}, []float64{
+					26,
+					7,
+				}, [][]float64{
+					{
+						20,
+						30,
+					},
+					{
+						5,
+						10,
+					},
+				})
+				seriesList[0].Label.Show = true
+				_, err := NewBulletChart(p, BulletChartOption{
+					Padding: Box{
+						Top:    10,
+						Right:  10,
+						Bottom: 10,
+						Left:   10,
+					},
+					SeriesList: seriesList,
+					XAxis: NewXAxisOption([]string{
+						"Users",
+						"Churn",
+					}),
+					Bullet: BulletOption{
+						Orient: OrientVertical,
+					},
+					BarWidth: 40,
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"10\" y=\"17\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">30</text><text x=\"10\" y=\"75\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">25</text><text x=\"10\" y=\"133\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"10\" y=\"192\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">15</text><text x=\"10\" y=\"250\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">10</text><text x=\"19\" y=\"308\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"19\" y=\"367\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path  d=\"M 38 10\nL 590 10\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 38 68\nL 590 68\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 38 126\nL 590 126\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 38 185\nL 590 185\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 38 243\nL 590 243\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 38 301\nL 590 301\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 38 365\nL 38 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 314 365\nL 314 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 590 365\nL 590 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 38 360\nL 590 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"156\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Users</text><text x=\"432\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Churn</text><path  d=\"M 156 10\nL 196 10\nL 196 360\nL 156 360\nL 156 10\" style=\"stroke-width:0;stroke:none;fill:rgba(237,237,237,1.0)\"/><path  d=\"M 156 127\nL 196 127\nL 196 360\nL 156 360\nL 156 127\" style=\"stroke-width:0;stroke:none;fill:rgba(190,190,190,1.0)\"/><path  d=\"M 170 92\nL 183 92\nL 183 360\nL 170 360\nL 170 92\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 161 56\nL 191 56\nL 191 59\nL 161 59\nL 161 56\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0)\"/><path  d=\"M 432 244\nL 472 244\nL 472 360\nL 432 360\nL 432 244\" style=\"stroke-width:0;stroke:none;fill:rgba(237,237,237,1.0)\"/><path  d=\"M 432 302\nL 472 302\nL 472 360\nL 432 360\nL 432 302\" style=\"stroke-width:0;stroke:none;fill:rgba(190,190,190,1.0)\"/><path  d=\"M 446 267\nL 459 267\nL 459 360\nL 446 360\nL 446 267\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 437 278\nL 467 278\nL 467 281\nL 437 281\nL 437 278\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0)\"/><text x=\"167\" y=\"87\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">23</text><text x=\"449\" y=\"262\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">8</text></svg>",
+		},
+	}
+
+	for _, tt := range tests {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		data, err := tt.render(p)
+		assert.Nil(err)
+		assert.Equal(tt.result, string(data))
+	}
+}
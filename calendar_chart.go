@@ -0,0 +1,341 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/golang/freetype/truetype"
+)
+
+type calendarChart struct {
+	p   *Painter
+	opt *CalendarChartOption
+}
+
+// NewCalendarSeriesList returns a series list for calendar chart,
+// the time of series data is the date of value
+func NewCalendarSeriesList(data []SeriesData) SeriesList {
+	return SeriesList{
+		{
+			Type: ChartTypeCalendar,
+			Data: data,
+		},
+	}
+}
+
+// NewCalendarSeriesListFromMap returns a series list for calendar chart,
+// the key of map is the date of value, the data is sorted by date
+func NewCalendarSeriesListFromMap(values map[time.Time]float64) SeriesList {
+	data := make([]SeriesData, 0, len(values))
+	for t, value := range values {
+		data = append(data, SeriesData{
+			Time:  t,
+			Value: value,
+		})
+	}
+	sort.Slice(data, func(i, j int) bool {
+		return data[i].Time.Before(data[j].Time)
+	})
+	return NewCalendarSeriesList(data)
+}
+
+// NewCalendarChart returns a calendar chart renderer
+func NewCalendarChart(p *Painter, opt CalendarChartOption) *calendarChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &calendarChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+type CalendarOption struct {
+	// The first date of calendar, default is the min date of series list
+	Start time.Time
+	// The last date of calendar, default is the max date of series list
+	End time.Time
+	// The first day of week, default is sunday
+	FirstDay time.Weekday
+	// The max size of day cell, default is filling the chart
+	CellSize int
+	// The gap between day cells, default is 2
+	CellGap *int
+	// The colors of continuous color scale, default is from background color to the first series color of theme
+	Colors []Color
+}
+
+type CalendarChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The data series list
+	SeriesList SeriesList
+	// The padding of calendar chart
+	Padding Box
+	// The option of title
+	Title TitleOption
+	// The option of calendar
+	Calendar CalendarOption
+	// background is filled
+	backgroundIsFilled bool
+}
+
+const defaultCalendarCellGap = 2
+
+// calendarDate returns the start of day
+func calendarDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// calendarDay is the date of calendar, it's used as the key of values
+// because the same date in different location is not equal as time
+type calendarDay struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func newCalendarDay(t time.Time) calendarDay {
+	return calendarDay{
+		year:  t.Year(),
+		month: t.Month(),
+		day:   t.Day(),
+	}
+}
+
+// time returns the start of day in the location
+func (d calendarDay) time(loc *time.Location) time.Time {
+	return time.Date(d.year, d.month, d.day, 0, 0, 0, 0, loc)
+}
+
+// calendarDays returns the number of days from start to end
+func calendarDays(start, end time.Time) int {
+	// 使用四舍五入避免夏令时的影响
+	return int(math.Round(end.Sub(start).Hours() / 24))
+}
+
+// getCalendarValues returns the value of each date, the values of the same date are summed
+func getCalendarValues(seriesList SeriesList) map[calendarDay]float64 {
+	values := make(map[calendarDay]float64)
+	for _, series := range seriesList {
+		for _, item := range series.Data {
+			if item.Time.IsZero() || item.Value == nullValue {
+				continue
+			}
+			values[newCalendarDay(item.Time)] += item.Value
+		}
+	}
+	return values
+}
+
+// getDateRange returns the start and end date of calendar,
+// the dates are in the location of start(or end), default is UTC
+func (opt *CalendarOption) getDateRange(values map[calendarDay]float64) (time.Time, time.Time) {
+	loc := time.UTC
+	if !opt.Start.IsZero() {
+		loc = opt.Start.Location()
+	} else if !opt.End.IsZero() {
+		loc = opt.End.Location()
+	}
+	start := opt.Start
+	end := opt.End
+	if !end.IsZero() {
+		end = newCalendarDay(end).time(loc)
+	}
+	for day := range values {
+		date := day.time(loc)
+		if opt.Start.IsZero() && (start.IsZero() || date.Before(start)) {
+			start = date
+		}
+		if opt.End.IsZero() && (end.IsZero() || date.After(end)) {
+			end = date
+		}
+	}
+	return calendarDate(start), calendarDate(end)
+}
+
+// getColors returns the colors of continuous color scale
+func (opt *CalendarOption) getColors(theme ColorPalette) []Color {
+	if len(opt.Colors) != 0 {
+		return opt.Colors
+	}
+	color := theme.GetSeriesColor(0)
+	// 由背景色过渡至系列颜色，兼容暗色主题
+	return []Color{
+		interpolateColor([]Color{
+			color,
+			theme.GetBackgroundColor(),
+		}, 0.8),
+		color,
+	}
+}
+
+func (c *calendarChart) render(result *defaultRenderResult, seriesList SeriesList) (Box, error) {
+	p := c.p
+	opt := c.opt
+	theme := opt.Theme
+	seriesPainter := result.seriesPainter
+
+	values := getCalendarValues(seriesList)
+	start, end := opt.Calendar.getDateRange(values)
+	if start.IsZero() || end.Before(start) {
+		return p.box, nil
+	}
+	// 第一列为开始日期所在的星期
+	offset := (int(start.Weekday()) - int(opt.Calendar.FirstDay) + 7) % 7
+	weekStart := start.AddDate(0, 0, -offset)
+	weekCount := calendarDays(weekStart, end)/7 + 1
+
+	seriesPainter.OverrideTextStyle(Style{
+		Font:      opt.Font,
+		FontSize:  labelFontSize,
+		FontColor: theme.GetTextColor(),
+	})
+	// 星期仅展示间隔的行
+	weekdayNames := make([]string, 7)
+	for row := 1; row < 7; row += 2 {
+		name := time.Weekday((int(opt.Calendar.FirstDay) + row) % 7).String()
+		weekdayNames[row] = name[:3]
+	}
+	labelWidth, _ := measureTextMaxWidthHeight(weekdayNames, seriesPainter)
+	labelHeight := seriesPainter.MeasureText("Jan").Height()
+	labelMargin := 5
+
+	width := seriesPainter.Width() - labelWidth - labelMargin
+	height := seriesPainter.Height() - labelHeight - labelMargin
+	cellSize := width / weekCount
+	if height/7 < cellSize {
+		cellSize = height / 7
+	}
+	if opt.Calendar.CellSize > 0 && opt.Calendar.CellSize < cellSize {
+		cellSize = opt.Calendar.CellSize
+	}
+	if cellSize <= 0 {
+		return p.box, nil
+	}
+	gap := defaultCalendarCellGap
+	if opt.Calendar.CellGap != nil {
+		gap = *opt.Calendar.CellGap
+	}
+	if gap < 0 || gap*2 >= cellSize {
+		gap = 0
+	}
+	// 日历居中展示
+	left := labelWidth + labelMargin + (width-weekCount*cellSize)>>1
+	top := labelHeight + labelMargin + (height-7*cellSize)>>1
+
+	for row, name := range weekdayNames {
+		if name == "" {
+			continue
+		}
+		textBox := seriesPainter.MeasureText(name)
+		y := top + row*cellSize + (cellSize-gap+textBox.Height())>>1
+		seriesPainter.Text(name, left-labelMargin-textBox.Width(), y)
+	}
+
+	min := 0.0
+	max := 0.0
+	for _, value := range values {
+		min = math.Min(min, value)
+		max = math.Max(max, value)
+	}
+	visualMap := VisualMapOption{
+		Min:    &min,
+		Max:    &max,
+		Colors: opt.Calendar.getColors(theme),
+	}
+	emptyColor := theme.GetAxisSplitLineColor()
+
+	monthLabelRight := 0
+	for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+		days := calendarDays(weekStart, date)
+		col := days / 7
+		row := days % 7
+		x := left + col*cellSize
+		y := top + row*cellSize
+
+		// 月份展示于每月第一天所在的列，空间不足则不展示
+		showMonth := date.Day() == 1
+		// 开始日期距下个月不足两周则不展示
+		if date.Equal(start) {
+			nextMonth := time.Date(date.Year(), date.Month()+1, 1, 0, 0, 0, 0, date.Location())
+			showMonth = calendarDays(date, nextMonth) >= 14
+		}
+		if showMonth {
+			text := date.Format("Jan")
+			textBox := seriesPainter.MeasureText(text)
+			if x >= monthLabelRight {
+				seriesPainter.Text(text, x, top-labelMargin)
+				monthLabelRight = x + textBox.Width() + labelMargin
+			}
+		}
+
+		fillColor := emptyColor
+		if value, ok := values[newCalendarDay(date)]; ok {
+			fillColor = visualMap.GetColor(value)
+		}
+		seriesPainter.OverrideDrawingStyle(Style{
+			FillColor: fillColor,
+		}).Rect(Box{
+			Top:    y,
+			Left:   x,
+			Right:  x + cellSize - gap,
+			Bottom: y + cellSize - gap,
+		})
+	}
+
+	return p.box, nil
+}
+
+func (c *calendarChart) Render() (Box, error) {
+	opt := c.opt
+
+	renderResult, err := defaultRender(c.p, defaultRenderOption{
+		Theme:      opt.Theme,
+		Padding:    opt.Padding,
+		SeriesList: opt.SeriesList,
+		XAxis: XAxisOption{
+			Show: FalseFlag(),
+		},
+		YAxisOptions: []YAxisOption{
+			{
+				Show: FalseFlag(),
+			},
+		},
+		TitleOption: opt.Title,
+		LegendOption: LegendOption{
+			Show: FalseFlag(),
+		},
+		backgroundIsFilled: opt.backgroundIsFilled,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	seriesList := opt.SeriesList.Filter(ChartTypeCalendar)
+	return c.render(renderResult, seriesList)
+}
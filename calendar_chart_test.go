@@ -0,0 +1,210 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCalendarSeriesList(t *testing.T) {
+	assert := assert.New(t)
+
+	day := func(d int) time.Time {
+		return time.Date(2022, 6, d, 0, 0, 0, 0, time.UTC)
+	}
+	seriesList := NewCalendarSeriesListFromMap(map[time.Time]float64{
+		day(3): 3,
+		day(1): 1,
+		day(2): 2,
+	})
+	assert.Equal(SeriesList{
+		{
+			Type: ChartTypeCalendar,
+			Data: []SeriesData{
+				{
+					Time:  day(1),
+					Value: 1,
+				},
+				{
+					Time:  day(2),
+					Value: 2,
+				},
+				{
+					Time:  day(3),
+					Value: 3,
+				},
+			},
+		},
+	}, seriesList)
+}
+
+func TestGetCalendarValues(t *testing.T) {
+	assert := assert.New(t)
+
+	day := func(d, hour int) time.Time {
+		return time.Date(2022, 6, d, hour, 0, 0, 0, time.UTC)
+	}
+	values := getCalendarValues(NewCalendarSeriesList([]SeriesData{
+		{
+			Time:  day(1, 8),
+			Value: 1,
+		},
+		{
+			Time:  day(1, 20),
+			Value: 2,
+		},
+		{
+			Time:  day(5, 0),
+			Value: nullValue,
+		},
+		{
+			Value: 10,
+		},
+		{
+			Time:  day(9, 12),
+			Value: 4,
+		},
+	}))
+	assert.Equal(map[calendarDay]float64{
+		newCalendarDay(day(1, 0)): 3,
+		newCalendarDay(day(9, 0)): 4,
+	}, values)
+
+	opt := CalendarOption{}
+	start, end := opt.getDateRange(values)
+	assert.Equal(day(1, 0), start)
+	assert.Equal(day(9, 0), end)
+
+	opt = CalendarOption{
+		End: day(30, 10),
+	}
+	start, end = opt.getDateRange(values)
+	assert.Equal(day(1, 0), start)
+	assert.Equal(day(30, 0), end)
+
+	// 日期使用开始日期的时区
+	loc := time.FixedZone("UTC+8", 8*3600)
+	opt = CalendarOption{
+		Start: time.Date(2022, 5, 30, 10, 0, 0, 0, loc),
+		End:   day(30, 10),
+	}
+	start, end = opt.getDateRange(values)
+	assert.Equal(time.Date(2022, 5, 30, 0, 0, 0, 0, loc), start)
+	assert.Equal(time.Date(2022, 6, 30, 0, 0, 0, 0, loc), end)
+	assert.Equal(3.0, values[newCalendarDay(start.AddDate(0, 0, 2))])
+}
+
+func TestCalendarChart(t *testing.T) {
+	assert := assert.New(t)
+
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2022, month, d, 0, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		render func(*Painter) ([]byte, error)
+		result string
+	}{
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewCalendarChart(p, CalendarChartOption{
+					Title: TitleOption{
+						Text: "Incidents",
+					},
+					Padding: Box{
+						Top:    10,
+						Right:  10,
+						Bottom: 10,
+						Left:   10,
+					},
+					SeriesList: NewCalendarSeriesListFromMap(map[time.Time]float64{
+						day(1, 3):  1,
+						day(1, 20): 4,
+						day(2, 1):  0,
+						day(2, 14): 2,
+						day(3, 8):  6,
+					}),
+					Calendar: CalendarOption{
+						End:      day(3, 31),
+						FirstDay: time.Monday,
+						CellSize: 20,
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"10\" y=\"25\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Incidents</text><text x=\"156\" y=\"176\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"156\" y=\"216\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"159\" y=\"256\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"183\" y=\"136\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Jan</text><path  d=\"M 183 141\nL 201 141\nL 201 159\nL 183 159\nL 183 141\" style=\"stroke-width:0;stroke:none;fill:rgba(198,207,236,1.0)\"/><path  d=\"M 183 161\nL 201 161\nL 201 179\nL 183 179\nL 183 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 183 181\nL 201 181\nL 201 199\nL 183 199\nL 183 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 183 201\nL 201 201\nL 201 219\nL 183 219\nL 183 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 183 221\nL 201 221\nL 201 239\nL 183 239\nL 183 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 183 241\nL 201 241\nL 201 259\nL 183 259\nL 183 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 183 261\nL 201 261\nL 201 279\nL 183 279\nL 183 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 203 141\nL 221 141\nL 221 159\nL 203 159\nL 203 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 203 161\nL 221 161\nL 221 179\nL 203 179\nL 203 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 203 181\nL 221 181\nL 221 199\nL 203 199\nL 203 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 203 201\nL 221 201\nL 221 219\nL 203 219\nL 203 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 203 221\nL 221 221\nL 221 239\nL 203 239\nL 203 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 203 241\nL 221 241\nL 221 259\nL 203 259\nL 203 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 203 261\nL 221 261\nL 221 279\nL 203 279\nL 203 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 223 141\nL 241 141\nL 241 159\nL 223 159\nL 223 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 223 161\nL 241 161\nL 241 179\nL 223 179\nL 223 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 223 181\nL 241 181\nL 241 199\nL 223 199\nL 223 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 223 201\nL 241 201\nL 241 219\nL 223 219\nL 223 201\" style=\"stroke-width:0;stroke:none;fill:rgba(130,150,213,1.0)\"/><path  d=\"M 223 221\nL 241 221\nL 241 239\nL 223 239\nL 223 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 223 241\nL 241 241\nL 241 259\nL 223 259\nL 223 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 223 261\nL 241 261\nL 241 279\nL 223 279\nL 223 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 243 141\nL 261 141\nL 261 159\nL 243 159\nL 243 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 243 161\nL 261 161\nL 261 179\nL 243 179\nL 243 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 243 181\nL 261 181\nL 261 199\nL 243 199\nL 243 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 243 201\nL 261 201\nL 261 219\nL 243 219\nL 243 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 243 221\nL 261 221\nL 261 239\nL 243 239\nL 243 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 243 241\nL 261 241\nL 261 259\nL 243 259\nL 243 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 243 261\nL 261 261\nL 261 279\nL 243 279\nL 243 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 263 141\nL 281 141\nL 281 159\nL 263 159\nL 263 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><text x=\"263\" y=\"136\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Feb</text><path  d=\"M 263 161\nL 281 161\nL 281 179\nL 263 179\nL 263 161\" style=\"stroke-width:0;stroke:none;fill:rgba(221,226,244,1.0)\"/><path  d=\"M 263 181\nL 281 181\nL 281 199\nL 263 199\nL 263 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 263 201\nL 281 201\nL 281 219\nL 263 219\nL 263 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 263 221\nL 281 221\nL 281 239\nL 263 239\nL 263 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 263 241\nL 281 241\nL 281 259\nL 263 259\nL 263 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 263 261\nL 281 261\nL 281 279\nL 263 279\nL 263 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 283 141\nL 301 141\nL 301 159\nL 283 159\nL 283 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 283 161\nL 301 161\nL 301 179\nL 283 179\nL 283 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 283 181\nL 301 181\nL 301 199\nL 283 199\nL 283 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 283 201\nL 301 201\nL 301 219\nL 283 219\nL 283 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 283 221\nL 301 221\nL 301 239\nL 283 239\nL 283 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 283 241\nL 301 241\nL 301 259\nL 283 259\nL 283 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 283 261\nL 301 261\nL 301 279\nL 283 279\nL 283 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 303 141\nL 321 141\nL 321 159\nL 303 159\nL 303 141\" style=\"stroke-width:0;stroke:none;fill:rgba(175,188,229,1.0)\"/><path  d=\"M 303 161\nL 321 161\nL 321 179\nL 303 179\nL 303 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 303 181\nL 321 181\nL 321 199\nL 303 199\nL 303 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 303 201\nL 321 201\nL 321 219\nL 303 219\nL 303 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 303 221\nL 321 221\nL 321 239\nL 303 239\nL 303 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 303 241\nL 321 241\nL 321 259\nL 303 259\nL 303 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 303 261\nL 321 261\nL 321 279\nL 303 279\nL 303 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 323 141\nL 341 141\nL 341 159\nL 323 159\nL 323 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 323 161\nL 341 161\nL 341 179\nL 323 179\nL 323 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 323 181\nL 341 181\nL 341 199\nL 323 199\nL 323 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 323 201\nL 341 201\nL 341 219\nL 323 219\nL 323 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 323 221\nL 341 221\nL 341 239\nL 323 239\nL 323 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 323 241\nL 341 241\nL 341 259\nL 323 259\nL 323 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 323 261\nL 341 261\nL 341 279\nL 323 279\nL 323 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 343 141\nL 361 141\nL 361 159\nL 343 159\nL 343 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><text x=\"343\" y=\"136\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Mar</text><path  d=\"M 343 161\nL 361 161\nL 361 179\nL 343 179\nL 343 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 343 181\nL 361 181\nL 361 199\nL 343 199\nL 343 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 343 201\nL 361 201\nL 361 219\nL 343 219\nL 343 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 343 221\nL 361 221\nL 361 239\nL 343 239\nL 343 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 343 241\nL 361 241\nL 361 259\nL 343 259\nL 343 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 343 261\nL 361 261\nL 361 279\nL 343 279\nL 343 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 363 141\nL 381 141\nL 381 159\nL 363 159\nL 363 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 363 161\nL 381 161\nL 381 179\nL 363 179\nL 363 161\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 363 181\nL 381 181\nL 381 199\nL 363 199\nL 363 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 363 201\nL 381 201\nL 381 219\nL 363 219\nL 363 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 363 221\nL 381 221\nL 381 239\nL 363 239\nL 363 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 363 241\nL 381 241\nL 381 259\nL 363 259\nL 363 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 363 261\nL 381 261\nL 381 279\nL 363 279\nL 363 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 383 141\nL 401 141\nL 401 159\nL 383 159\nL 383 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 383 161\nL 401 161\nL 401 179\nL 383 179\nL 383 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 383 181\nL 401 181\nL 401 199\nL 383 199\nL 383 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 383 201\nL 401 201\nL 401 219\nL 383 219\nL 383 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 383 221\nL 401 221\nL 401 239\nL 383 239\nL 383 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 383 241\nL 401 241\nL 401 259\nL 383 259\nL 383 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 383 261\nL 401 261\nL 401 279\nL 383 279\nL 383 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 403 141\nL 421 141\nL 421 159\nL 403 159\nL 403 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 403 161\nL 421 161\nL 421 179\nL 403 179\nL 403 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 403 181\nL 421 181\nL 421 199\nL 403 199\nL 403 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 403 201\nL 421 201\nL 421 219\nL 403 219\nL 403 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 403 221\nL 421 221\nL 421 239\nL 403 239\nL 403 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 403 241\nL 421 241\nL 421 259\nL 403 259\nL 403 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 403 261\nL 421 261\nL 421 279\nL 403 279\nL 403 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 423 141\nL 441 141\nL 441 159\nL 423 159\nL 423 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 423 161\nL 441 161\nL 441 179\nL 423 179\nL 423 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 423 181\nL 441 181\nL 441 199\nL 423 199\nL 423 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 423 201\nL 441 201\nL 441 219\nL 423 219\nL 423 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/></svg>",
+		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				// 开始日期与数据的时区不一致
+				loc := time.FixedZone("UTC+8", 8*3600)
+				_, err := NewCalendarChart(p, CalendarChartOption{
+					Title: TitleOption{
+						Text: "Incidents",
+					},
+					Padding: Box{
+						Top:    10,
+						Right:  10,
+						Bottom: 10,
+						Left:   10,
+					},
+					SeriesList: NewCalendarSeriesListFromMap(map[time.Time]float64{
+						day(1, 3):  1,
+						day(1, 20): 4,
+						day(2, 1):  0,
+						day(2, 14): 2,
+						day(3, 8):  6,
+					}),
+					Calendar: CalendarOption{
+						Start:    time.Date(2022, 1, 3, 0, 0, 0, 0, loc),
+						End:      time.Date(2022, 3, 31, 0, 0, 0, 0, loc),
+						FirstDay: time.Monday,
+						CellSize: 20,
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"10\" y=\"25\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Incidents</text><text x=\"156\" y=\"176\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"156\" y=\"216\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"159\" y=\"256\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"183\" y=\"136\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Jan</text><path  d=\"M 183 141\nL 201 141\nL 201 159\nL 183 159\nL 183 141\" style=\"stroke-width:0;stroke:none;fill:rgba(198,207,236,1.0)\"/><path  d=\"M 183 161\nL 201 161\nL 201 179\nL 183 179\nL 183 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 183 181\nL 201 181\nL 201 199\nL 183 199\nL 183 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 183 201\nL 201 201\nL 201 219\nL 183 219\nL 183 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 183 221\nL 201 221\nL 201 239\nL 183 239\nL 183 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 183 241\nL 201 241\nL 201 259\nL 183 259\nL 183 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 183 261\nL 201 261\nL 201 279\nL 183 279\nL 183 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 203 141\nL 221 141\nL 221 159\nL 203 159\nL 203 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 203 161\nL 221 161\nL 221 179\nL 203 179\nL 203 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 203 181\nL 221 181\nL 221 199\nL 203 199\nL 203 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 203 201\nL 221 201\nL 221 219\nL 203 219\nL 203 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 203 221\nL 221 221\nL 221 239\nL 203 239\nL 203 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 203 241\nL 221 241\nL 221 259\nL 203 259\nL 203 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 203 261\nL 221 261\nL 221 279\nL 203 279\nL 203 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 223 141\nL 241 141\nL 241 159\nL 223 159\nL 223 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 223 161\nL 241 161\nL 241 179\nL 223 179\nL 223 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 223 181\nL 241 181\nL 241 199\nL 223 199\nL 223 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 223 201\nL 241 201\nL 241 219\nL 223 219\nL 223 201\" style=\"stroke-width:0;stroke:none;fill:rgba(130,150,213,1.0)\"/><path  d=\"M 223 221\nL 241 221\nL 241 239\nL 223 239\nL 223 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 223 241\nL 241 241\nL 241 259\nL 223 259\nL 223 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 223 261\nL 241 261\nL 241 279\nL 223 279\nL 223 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 243 141\nL 261 141\nL 261 159\nL 243 159\nL 243 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 243 161\nL 261 161\nL 261 179\nL 243 179\nL 243 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 243 181\nL 261 181\nL 261 199\nL 243 199\nL 243 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 243 201\nL 261 201\nL 261 219\nL 243 219\nL 243 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 243 221\nL 261 221\nL 261 239\nL 243 239\nL 243 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 243 241\nL 261 241\nL 261 259\nL 243 259\nL 243 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 243 261\nL 261 261\nL 261 279\nL 243 279\nL 243 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 263 141\nL 281 141\nL 281 159\nL 263 159\nL 263 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><text x=\"263\" y=\"136\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Feb</text><path  d=\"M 263 161\nL 281 161\nL 281 179\nL 263 179\nL 263 161\" style=\"stroke-width:0;stroke:none;fill:rgba(221,226,244,1.0)\"/><path  d=\"M 263 181\nL 281 181\nL 281 199\nL 263 199\nL 263 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 263 201\nL 281 201\nL 281 219\nL 263 219\nL 263 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 263 221\nL 281 221\nL 281 239\nL 263 239\nL 263 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 263 241\nL 281 241\nL 281 259\nL 263 259\nL 263 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 263 261\nL 281 261\nL 281 279\nL 263 279\nL 263 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 283 141\nL 301 141\nL 301 159\nL 283 159\nL 283 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 283 161\nL 301 161\nL 301 179\nL 283 179\nL 283 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 283 181\nL 301 181\nL 301 199\nL 283 199\nL 283 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 283 201\nL 301 201\nL 301 219\nL 283 219\nL 283 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 283 221\nL 301 221\nL 301 239\nL 283 239\nL 283 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 283 241\nL 301 241\nL 301 259\nL 283 259\nL 283 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 283 261\nL 301 261\nL 301 279\nL 283 279\nL 283 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 303 141\nL 321 141\nL 321 159\nL 303 159\nL 303 141\" style=\"stroke-width:0;stroke:none;fill:rgba(175,188,229,1.0)\"/><path  d=\"M 303 161\nL 321 161\nL 321 179\nL 303 179\nL 303 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 303 181\nL 321 181\nL 321 199\nL 303 199\nL 303 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 303 201\nL 321 201\nL 321 219\nL 303 219\nL 303 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 303 221\nL 321 221\nL 321 239\nL 303 239\nL 303 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 303 241\nL 321 241\nL 321 259\nL 303 259\nL 303 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 303 261\nL 321 261\nL 321 279\nL 303 279\nL 303 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 323 141\nL 341 141\nL 341 159\nL 323 159\nL 323 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 323 161\nL 341 161\nL 341 179\nL 323 179\nL 323 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 323 181\nL 341 181\nL 341 199\nL 323 199\nL 323 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 323 201\nL 341 201\nL 341 219\nL 323 219\nL 323 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 323 221\nL 341 221\nL 341 239\nL 323 239\nL 323 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 323 241\nL 341 241\nL 341 259\nL 323 259\nL 323 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 323 261\nL 341 261\nL 341 279\nL 323 279\nL 323 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 343 141\nL 361 141\nL 361 159\nL 343 159\nL 343 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><text x=\"343\" y=\"136\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Mar</text><path  d=\"M 343 161\nL 361 161\nL 361 179\nL 343 179\nL 343 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 343 181\nL 361 181\nL 361 199\nL 343 199\nL 343 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 343 201\nL 361 201\nL 361 219\nL 343 219\nL 343 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 343 221\nL 361 221\nL 361 239\nL 343 239\nL 343 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 343 241\nL 361 241\nL 361 259\nL 343 259\nL 343 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 343 261\nL 361 261\nL 361 279\nL 343 279\nL 343 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 363 141\nL 381 141\nL 381 159\nL 363 159\nL 363 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 363 161\nL 381 161\nL 381 179\nL 363 179\nL 363 161\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 363 181\nL 381 181\nL 381 199\nL 363 199\nL 363 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 363 201\nL 381 201\nL 381 219\nL 363 219\nL 363 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 363 221\nL 381 221\nL 381 239\nL 363 239\nL 363 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 363 241\nL 381 241\nL 381 259\nL 363 259\nL 363 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 363 261\nL 381 261\nL 381 279\nL 363 279\nL 363 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 383 141\nL 401 141\nL 401 159\nL 383 159\nL 383 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 383 161\nL 401 161\nL 401 179\nL 383 179\nL 383 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 383 181\nL 401 181\nL 401 199\nL 383 199\nL 383 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 383 201\nL 401 201\nL 401 219\nL 383 219\nL 383 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 383 221\nL 401 221\nL 401 239\nL 383 239\nL 383 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 383 241\nL 401 241\nL 401 259\nL 383 259\nL 383 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 383 261\nL 401 261\nL 401 279\nL 383 279\nL 383 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 403 141\nL 421 141\nL 421 159\nL 403 159\nL 403 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 403 161\nL 421 161\nL 421 179\nL 403 179\nL 403 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 403 181\nL 421 181\nL 421 199\nL 403 199\nL 403 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 403 201\nL 421 201\nL 421 219\nL 403 219\nL 403 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 403 221\nL 421 221\nL 421 239\nL 403 239\nL 403 221\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 403 241\nL 421 241\nL 421 259\nL 403 259\nL 403 241\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 403 261\nL 421 261\nL 421 279\nL 403 279\nL 403 261\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 423 141\nL 441 141\nL 441 159\nL 423 159\nL 423 141\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 423 161\nL 441 161\nL 441 179\nL 423 179\nL 423 161\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 423 181\nL 441 181\nL 441 199\nL 423 199\nL 423 181\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 423 201\nL 441 201\nL 441 219\nL 423 219\nL 423 201\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/></svg>",
+		},
+	}
+
+	for _, tt := range tests {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		data, err := tt.render(p)
+		assert.Nil(err)
+		assert.Equal(tt.result, string(data))
+	}
+}
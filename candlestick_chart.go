@@ -0,0 +1,258 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"github.com/golang/freetype/truetype"
+)
+
+type candlestickChart struct {
+	p   *Painter
+	opt *CandlestickChartOption
+}
+
+// NewCandlestickSeriesList returns a series list for candlestick chart,
+// the value of each point is [open, close, lowest, highest]
+func NewCandlestickSeriesList(values [][][]float64) SeriesList {
+	seriesList := make(SeriesList, len(values))
+	for index, points := range values {
+		data := make([]SeriesData, len(points))
+		for j, value := range points {
+			data[j] = newCandlestickSeriesData(value)
+		}
+		seriesList[index] = Series{
+			Type: ChartTypeCandlestick,
+			Data: data,
+		}
+	}
+	return seriesList
+}
+
+// CandlestickData is the data of candlestick chart
+type CandlestickData struct {
+	// The open value
+	Open float64
+	// The close value
+	Close float64
+	// The lowest value
+	Low float64
+	// The highest value
+	High float64
+}
+
+func newCandlestickSeriesData(values []float64) SeriesData {
+	if len(values) < 4 {
+		return SeriesData{
+			Value: nullValue,
+		}
+	}
+	return SeriesData{
+		// 以收盘价作为数据值
+		Value: values[1],
+		Candlestick: &CandlestickData{
+			Open:  values[0],
+			Close: values[1],
+			Low:   values[2],
+			High:  values[3],
+		},
+	}
+}
+
+// NewCandlestickChart returns a candlestick chart renderer
+func NewCandlestickChart(p *Painter, opt CandlestickChartOption) *candlestickChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &candlestickChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+type CandlestickChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The data series list
+	SeriesList SeriesList
+	// The x axis option
+	XAxis XAxisOption
+	// The padding of candlestick chart
+	Padding Box
+	// The y axis option
+	YAxisOptions []YAxisOption
+	// The option of title
+	Title TitleOption
+	// The legend option
+	Legend LegendOption
+	// The width of candlestick body
+	BarWidth int
+}
+
+func (c *candlestickChart) render(result *defaultRenderResult, seriesList SeriesList) (Box, error) {
+	p := c.p
+	opt := c.opt
+	seriesPainter := result.seriesPainter
+
+	xRange := newCategoryRange(c.p, len(opt.XAxis.Data), seriesPainter.Width())
+	x0, x1 := xRange.GetRange(0)
+	width := int(x1 - x0)
+	// 每一块之间的margin
+	margin := 10
+	// 每一个k线之间的margin
+	barMargin := 5
+	if width < 20 {
+		margin = 2
+		barMargin = 2
+	} else if width < 50 {
+		margin = 5
+		barMargin = 3
+	}
+	seriesCount := len(seriesList)
+	barWidth := (width - 2*margin - barMargin*(seriesCount-1)) / seriesCount
+	if opt.BarWidth > 0 && opt.BarWidth < barWidth {
+		barWidth = opt.BarWidth
+		// 重新计算margin
+		margin = (width - seriesCount*barWidth - barMargin*(seriesCount-1)) / 2
+	}
+	if barWidth < 1 {
+		barWidth = 1
+	}
+	theme := opt.Theme
+	divideValues := xRange.AutoDivide()
+
+	markPointPainter := NewMarkPointPainter(seriesPainter)
+	markLinePainter := NewMarkLinePainter(seriesPainter)
+	rendererList := []Renderer{
+		markPointPainter,
+		markLinePainter,
+	}
+	for index := range seriesList {
+		series := seriesList[index]
+		yRange := result.axisRanges[series.AxisIndex]
+		seriesColor := theme.GetSeriesColor(series.index)
+
+		points := make([]Point, len(series.Data))
+		for j, item := range series.Data {
+			if j >= xRange.divideCount || item.Value == nullValue || item.Candlestick == nil {
+				continue
+			}
+			candlestick := item.Candlestick
+			x := divideValues[j] + margin
+			if index != 0 {
+				x += index * (barWidth + barMargin)
+			}
+			center := x + barWidth>>1
+
+			// 收盘价不低于开盘价为上涨
+			color := getDownColor(theme)
+			if candlestick.Close >= candlestick.Open {
+				color = getUpColor(theme)
+			}
+			if !item.Style.FillColor.IsZero() {
+				color = item.Style.FillColor
+			}
+			openY := yRange.getRestHeight(candlestick.Open)
+			closeY := yRange.getRestHeight(candlestick.Close)
+			top := openY
+			bottom := closeY
+			if top > bottom {
+				top, bottom = bottom, top
+			}
+			// 开盘与收盘相同时，至少展示1px
+			if top == bottom {
+				bottom++
+			}
+
+			// 影线
+			seriesPainter.OverrideDrawingStyle(Style{
+				StrokeColor: color,
+				StrokeWidth: 1,
+			}).LineStroke([]Point{
+				{
+					X: center,
+					Y: yRange.getRestHeight(candlestick.High),
+				},
+				{
+					X: center,
+					Y: yRange.getRestHeight(candlestick.Low),
+				},
+			})
+			// 实体
+			seriesPainter.OverrideDrawingStyle(Style{
+				FillColor: color,
+			}).Rect(Box{
+				Top:    top,
+				Left:   x,
+				Right:  x + barWidth,
+				Bottom: bottom,
+			})
+			points[j] = Point{
+				X: center,
+				Y: closeY,
+			}
+		}
+
+		markPointPainter.Add(markPointRenderOption{
+			FillColor: seriesColor,
+			Font:      opt.Font,
+			Series:    series,
+			Points:    points,
+		})
+		markLinePainter.Add(markLineRenderOption{
+			FillColor:   seriesColor,
+			FontColor:   opt.Theme.GetTextColor(),
+			StrokeColor: seriesColor,
+			Font:        opt.Font,
+			Series:      series,
+			Range:       yRange,
+		})
+	}
+	// 最大、最小的mark point
+	err := doRender(rendererList...)
+	if err != nil {
+		return BoxZero, err
+	}
+
+	return p.box, nil
+}
+
+func (c *candlestickChart) Render() (Box, error) {
+	p := c.p
+	opt := c.opt
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		Theme:        opt.Theme,
+		Padding:      opt.Padding,
+		SeriesList:   opt.SeriesList,
+		XAxis:        opt.XAxis,
+		YAxisOptions: opt.YAxisOptions,
+		TitleOption:  opt.Title,
+		LegendOption: opt.Legend,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	seriesList := opt.SeriesList.Filter(ChartTypeCandlestick)
+	return c.render(renderResult, seriesList)
+}
@@ -0,0 +1,143 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCandlestickSeriesList(t *testing.T) {
+	assert := assert.New(t)
+
+	seriesList := NewCandlestickSeriesList([][][]float64{
+		{
+			{
+				20,
+				34,
+				10,
+				38,
+			},
+			{
+				40,
+				35,
+				30,
+				50,
+			},
+			{
+				1,
+			},
+		},
+	})
+	assert.Equal(SeriesData{
+		Value: 34,
+		Candlestick: &CandlestickData{
+			Open:  20,
+			Close: 34,
+			Low:   10,
+			High:  38,
+		},
+	}, seriesList[0].Data[0])
+	assert.Equal(nullValue, seriesList[0].Data[2].Value)
+
+	max, min := seriesList.GetMaxMin(0)
+	assert.Equal(50.0, max)
+	assert.Equal(10.0, min)
+}
+
+func TestCandlestickChart(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		render func(*Painter) ([]byte, error)
+		result string
+	}{
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewCandlestickChart(p, CandlestickChartOption{
+					Title: TitleOption{
+						Text: "Candlestick",
+					},
+					Padding: Box{
+						Left:   10,
+						Top:    10,
+						Right:  10,
+						Bottom: 10,
+					},
+					SeriesList: NewCandlestickSeriesList([][][]float64{
+						{
+							{
+								20,
+								34,
+								10,
+								38,
+							},
+							{
+								40,
+								35,
+								30,
+								50,
+							},
+							{
+								31,
+								38,
+								33,
+								44,
+							},
+							{
+								38,
+								15,
+								5,
+								42,
+							},
+						},
+					}),
+					XAxis: NewXAxisOption([]string{
+						"Mon",
+						"Tue",
+						"Wed",
+						"Thu",
+					}),
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"10\" y=\"25\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Candlestick</text><text x=\"10\" y=\"52\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">50</text><text x=\"10\" y=\"115\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"10\" y=\"178\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">30</text><text x=\"10\" y=\"241\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"10\" y=\"304\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">10</text><text x=\"19\" y=\"367\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path  d=\"M 38 45\nL 590 45\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 38 108\nL 590 108\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 38 171\nL 590 171\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 38 234\nL 590 234\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 38 297\nL 590 297\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 38 365\nL 38 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 176 365\nL 176 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 314 365\nL 314 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 452 365\nL 452 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 590 365\nL 590 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 38 360\nL 590 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"92\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"232\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"368\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"508\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><path  d=\"M 107 121\nL 107 297\" style=\"stroke-width:1;stroke:rgba(235,84,84,1.0);fill:none\"/><path  d=\"M 48 146\nL 166 146\nL 166 234\nL 48 234\nL 48 146\" style=\"stroke-width:0;stroke:none;fill:rgba(235,84,84,1.0)\"/><path  d=\"M 245 45\nL 245 171\" style=\"stroke-width:1;stroke:rgba(71,178,98,1.0);fill:none\"/><path  d=\"M 186 108\nL 304 108\nL 304 140\nL 186 140\nL 186 108\" style=\"stroke-width:0;stroke:none;fill:rgba(71,178,98,1.0)\"/><path  d=\"M 383 83\nL 383 153\" style=\"stroke-width:1;stroke:rgba(235,84,84,1.0);fill:none\"/><path  d=\"M 324 121\nL 442 121\nL 442 165\nL 324 165\nL 324 121\" style=\"stroke-width:0;stroke:none;fill:rgba(235,84,84,1.0)\"/><path  d=\"M 521 96\nL 521 329\" style=\"stroke-width:1;stroke:rgba(71,178,98,1.0);fill:none\"/><path  d=\"M 462 121\nL 580 121\nL 580 266\nL 462 266\nL 462 121\" style=\"stroke-width:0;stroke:none;fill:rgba(71,178,98,1.0)\"/></svg>",
+		},
+	}
+
+	for _, tt := range tests {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		data, err := tt.render(p)
+		assert.Nil(err)
+		assert.Equal(tt.result, string(data))
+	}
+}
@@ -24,6 +24,7 @@ package charts
 
 import (
 	"sort"
+	"time"
 
 	"github.com/golang/freetype/truetype"
 )
@@ -59,19 +60,56 @@ type ChartOption struct {
 	SeriesList SeriesList
 	// The radar indicator list
 	RadarIndicators []RadarIndicator
+	// The axis list of parallel chart
+	ParallelAxes []ParallelAxis
+	// The nodes of treemap chart
+	TreemapNodes []TreemapNode
+	// The nodes of sunburst chart
+	SunburstNodes []SunburstNode
+	// The nodes of sankey chart
+	SankeyNodes []SankeyNode
+	// The links of sankey chart
+	SankeyLinks []SankeyLink
+	// The visual map option of heatmap chart
+	VisualMap VisualMapOption
+	// The option of gauge chart
+	Gauge GaugeOption
+	// The option of sunburst chart
+	Sunburst SunburstOption
+	// The option of sankey chart
+	Sankey SankeyOption
+	// The option of histogram chart
+	Histogram HistogramOption
+	// The option of calendar chart
+	Calendar CalendarOption
+	// The option of sparkline
+	Sparkline SparklineOption
+	// The option of bullet chart
+	Bullet BulletOption
+	// The option of radial bar chart
+	RadialBar RadialBarOption
+	// The option of waterfall chart
+	Waterfall WaterfallOption
+	// The polar coordinate system, the bar, line and scatter series are drawn in it if it's set
+	Polar *PolarOption
 	// The background color of chart
 	BackgroundColor Color
 	// The flag for show symbol of line, set this to *false will hide symbol
 	SymbolShow *bool
-	// The stroke width of line chart
+	// The stroke width of line chart and parallel chart
 	LineStrokeWidth float64
-	// The bar with of bar chart
+	// The bar with of bar chart, it's also the body width of candlestick chart, box plot chart, waterfall chart and vertical bullet chart,
+	// and the ring width of radial bar chart
 	BarWidth int
-	// The bar height of horizontal bar chart
+	// The margin of bars in each category, set it to 0 to draw contiguous bars
+	BarMargin *int
+	// The bar height of horizontal bar chart, gantt chart and horizontal bullet chart
 	BarHeight int
 	// Fill the area of line chart
 	FillArea bool
-	// background fill (alpha) opacity
+	// The symbol size of scatter chart, default value is 10
+	SymbolSize float64
+	// background fill (alpha) opacity, it's also the line opacity of parallel chart
 	Opacity uint8
 	// The child charts
 	Children []ChartOption
@@ -144,6 +182,84 @@ func LegendLabelsOptionFunc(labels []string, left ...string) OptionFunc {
 	}
 }
 
+// VisualMapOptionFunc set visual map of chart
+func VisualMapOptionFunc(visualMap VisualMapOption) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.VisualMap = visualMap
+	}
+}
+
+// GaugeOptionFunc set gauge option of chart
+func GaugeOptionFunc(gauge GaugeOption) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.Gauge = gauge
+	}
+}
+
+// SunburstOptionFunc set sunburst option of chart
+func SunburstOptionFunc(sunburst SunburstOption) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.Sunburst = sunburst
+	}
+}
+
+// SankeyOptionFunc set sankey option of chart
+func SankeyOptionFunc(sankey SankeyOption) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.Sankey = sankey
+	}
+}
+
+// CalendarOptionFunc set calendar option of chart
+func CalendarOptionFunc(calendar CalendarOption) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.Calendar = calendar
+	}
+}
+
+// SparklineOptionFunc set sparkline option of chart
+func SparklineOptionFunc(sparkline SparklineOption) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.Sparkline = sparkline
+	}
+}
+
+// BulletOptionFunc set bullet option of chart
+func BulletOptionFunc(bullet BulletOption) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.Bullet = bullet
+	}
+}
+
+// WaterfallOptionFunc set waterfall option of chart
+func WaterfallOptionFunc(waterfall WaterfallOption) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.Waterfall = waterfall
+	}
+}
+
+// RadialBarOptionFunc set radial bar option of chart
+func RadialBarOptionFunc(radialBar RadialBarOption) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.RadialBar = radialBar
+	}
+}
+
+// PolarOptionFunc set polar coordinate system of chart,
+// the bar, line and scatter series are drawn in it
+func PolarOptionFunc(polarOption PolarOption) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.Polar = &polarOption
+	}
+}
+
+// HistogramOptionFunc set histogram option of chart
+func HistogramOptionFunc(histogram HistogramOption) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.Histogram = histogram
+	}
+}
+
 // XAxisOptionFunc set x axis of chart
 func XAxisOptionFunc(xAxisOption XAxisOption) OptionFunc {
 	return func(opt *ChartOption) {
@@ -158,6 +274,24 @@ func XAxisDataOptionFunc(data []string, boundaryGap ...*bool) OptionFunc {
 	}
 }
 
+// XAxisTimeOptionFunc set time of series data and use time x axis,
+// the times are shared by all series
+func XAxisTimeOptionFunc(times []time.Time, location ...*time.Location) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.XAxis.Type = AxisTypeTime
+		if len(location) != 0 {
+			opt.XAxis.Location = location[0]
+		}
+		for _, series := range opt.SeriesList {
+			for index := range series.Data {
+				if index < len(times) {
+					series.Data[index].Time = times[index]
+				}
+			}
+		}
+	}
+}
+
 // YAxisOptionFunc set y axis of chart, support two y axis
 func YAxisOptionFunc(yAxisOption ...YAxisOption) OptionFunc {
 	return func(opt *ChartOption) {
@@ -209,6 +343,47 @@ func PieSeriesShowLabel() OptionFunc {
 	}
 }
 
+// PieSeriesRadius set the radius of pie series, the pie will be drawn as
+// donut if it's an inner and outer radius pair, e.g.: ("40%", "70%")
+func PieSeriesRadius(radius ...string) OptionFunc {
+	return func(opt *ChartOption) {
+		innerRadius := ""
+		outerRadius := ""
+		if len(radius) == 1 {
+			outerRadius = radius[0]
+		} else if len(radius) > 1 {
+			innerRadius = radius[0]
+			outerRadius = radius[1]
+		}
+		for index := range opt.SeriesList {
+			opt.SeriesList[index].InnerRadius = innerRadius
+			opt.SeriesList[index].Radius = outerRadius
+		}
+	}
+}
+
+// PieSeriesRoseType set the rose type of pie series, "radius" or "area"
+func PieSeriesRoseType(roseType string) OptionFunc {
+	return func(opt *ChartOption) {
+		for index := range opt.SeriesList {
+			opt.SeriesList[index].RoseType = roseType
+		}
+	}
+}
+
+// PieSeriesCenterLabel set the center label of donut, {c} is the sum value,
+// e.g.: "Total\n{c}"
+func PieSeriesCenterLabel(formatter string) OptionFunc {
+	return func(opt *ChartOption) {
+		for index := range opt.SeriesList {
+			opt.SeriesList[index].CenterLabel = SeriesLabel{
+				Show:      true,
+				Formatter: formatter,
+			}
+		}
+	}
+}
+
 // ChildOptionFunc add child chart
 func ChildOptionFunc(child ...ChartOption) OptionFunc {
 	return func(opt *ChartOption) {
@@ -226,6 +401,13 @@ func RadarIndicatorOptionFunc(names []string, values []float64) OptionFunc {
 	}
 }
 
+// ParallelAxisOptionFunc set parallel axis of chart
+func ParallelAxisOptionFunc(axes []ParallelAxis) OptionFunc {
+	return func(opt *ChartOption) {
+		opt.ParallelAxes = axes
+	}
+}
+
 // BackgroundColorOptionFunc set background color of chart
 func BackgroundColorOptionFunc(color Color) OptionFunc {
 	return func(opt *ChartOption) {
@@ -325,6 +507,126 @@ func BarRender(values [][]float64, opts ...OptionFunc) (*Painter, error) {
 	}, opts...)
 }
 
+// ScatterRender scatter chart render, the value of each point is [x, y] or [x, y, size]
+func ScatterRender(values [][][]float64, opts ...OptionFunc) (*Painter, error) {
+	seriesList := NewScatterSeriesList(values)
+	return Render(ChartOption{
+		SeriesList: seriesList,
+	}, opts...)
+}
+
+// CandlestickRender candlestick chart render, the value of each point is [open, close, lowest, highest]
+func CandlestickRender(values [][][]float64, opts ...OptionFunc) (*Painter, error) {
+	seriesList := NewCandlestickSeriesList(values)
+	return Render(ChartOption{
+		SeriesList: seriesList,
+	}, opts...)
+}
+
+// WaterfallRender waterfall chart render, the values are the changes of each step,
+// and the bars of total indexes are the running total
+func WaterfallRender(values []float64, totalIndexes []int, opts ...OptionFunc) (*Painter, error) {
+	seriesList := NewWaterfallSeriesList(values, totalIndexes...)
+	return Render(ChartOption{
+		SeriesList: seriesList,
+	}, opts...)
+}
+
+// BulletRender bullet chart render, each category has a value, a target and the qualitative ranges.
+// The category list is the data of y axis for horizontal bullet chart, or the data of x axis for vertical.
+func BulletRender(values, targets []float64, ranges [][]float64, opts ...OptionFunc) (*Painter, error) {
+	return Render(ChartOption{
+		SeriesList: NewBulletSeriesList(values, targets, ranges),
+	}, opts...)
+}
+
+// GanttRender gantt chart render, the tasks of the same group are a series
+func GanttRender(tasks []GanttTask, opts ...OptionFunc) (*Painter, error) {
+	seriesList, names := NewGanttSeriesList(tasks)
+	return Render(ChartOption{
+		SeriesList: seriesList,
+		YAxisOptions: []YAxisOption{
+			{
+				Data: names,
+			},
+		},
+	}, opts...)
+}
+
+// BoxPlotRender box plot chart render, the value of each point is
+// [lowest, Q1, median, Q3, highest], use NewBoxPlotSeriesListFromSamples
+// to compute them from raw samples
+func BoxPlotRender(values [][][]float64, opts ...OptionFunc) (*Painter, error) {
+	seriesList := NewBoxPlotSeriesList(values)
+	return Render(ChartOption{
+		SeriesList: seriesList,
+	}, opts...)
+}
+
+// HistogramRender histogram chart render, the samples are binned by the
+// option of HistogramOptionFunc, default is Freedman–Diaconis rule
+func HistogramRender(samples []float64, opts ...OptionFunc) (*Painter, error) {
+	opt := ChartOption{
+		BarMargin: NewIntPoint(0),
+	}
+	for _, fn := range opts {
+		fn(&opt)
+	}
+	bins, err := NewHistogramBins(samples, opt.Histogram)
+	if err != nil {
+		return nil, err
+	}
+	seriesList, labels := newHistogramSeriesList(bins, opt.Histogram.Cumulative)
+	opt.SeriesList = seriesList
+	if len(opt.XAxis.Data) == 0 {
+		opt.XAxis.Data = labels
+	}
+	if len(opt.YAxisOptions) == 0 {
+		opt.YAxisOptions = []YAxisOption{
+			{},
+		}
+		if opt.Histogram.Cumulative {
+			opt.YAxisOptions = append(opt.YAxisOptions, YAxisOption{
+				Min:       NewFloatPoint(0),
+				Max:       NewFloatPoint(100),
+				Formatter: "{value}%",
+			})
+		}
+	}
+	// option func已应用，不再重复传入
+	return Render(opt)
+}
+
+// TreemapRender treemap chart render
+func TreemapRender(nodes []TreemapNode, opts ...OptionFunc) (*Painter, error) {
+	return Render(ChartOption{
+		TreemapNodes: nodes,
+	}, opts...)
+}
+
+// SunburstRender sunburst chart render
+func SunburstRender(nodes []SunburstNode, opts ...OptionFunc) (*Painter, error) {
+	return Render(ChartOption{
+		SunburstNodes: nodes,
+	}, opts...)
+}
+
+// SankeyRender sankey chart render
+func SankeyRender(nodes []SankeyNode, links []SankeyLink, opts ...OptionFunc) (*Painter, error) {
+	return Render(ChartOption{
+		SankeyNodes: nodes,
+		SankeyLinks: links,
+	}, opts...)
+}
+
+// HeatmapRender heatmap chart render, values[y][x] is the value of cell
+func HeatmapRender(values [][]float64, opts ...OptionFunc) (*Painter, error) {
+	seriesList := NewHeatmapSeriesList(values)
+	return Render(ChartOption{
+		SeriesList: seriesList,
+	}, opts...)
+}
+
 // HorizontalBarRender horizontal bar chart render
 func HorizontalBarRender(values [][]float64, opts ...OptionFunc) (*Painter, error) {
 	seriesList := NewSeriesListDataFromValues(values, ChartTypeHorizontalBar)
@@ -348,6 +650,14 @@ func RadarRender(values [][]float64, opts ...OptionFunc) (*Painter, error) {
 	}, opts...)
 }
 
+// ParallelRender parallel chart render, values[i][j] is the values of each dimension
+// of the j-th record in the i-th category, the records of a category have the same color
+func ParallelRender(values [][][]float64, opts ...OptionFunc) (*Painter, error) {
+	return Render(ChartOption{
+		SeriesList: NewParallelSeriesList(values),
+	}, opts...)
+}
+
 // FunnelRender funnel chart render
 func FunnelRender(values []float64, opts ...OptionFunc) (*Painter, error) {
 	seriesList := NewFunnelSeriesList(values)
@@ -356,6 +666,71 @@ func FunnelRender(values []float64, opts ...OptionFunc) (*Painter, error) {
 	}, opts...)
 }
 
+// CalendarRender calendar chart render, the key of values is the date
+func CalendarRender(values map[time.Time]float64, opts ...OptionFunc) (*Painter, error) {
+	return Render(ChartOption{
+		SeriesList: NewCalendarSeriesListFromMap(values),
+	}, opts...)
+}
+
+// SparklineRender sparkline render, the default size is 120x30 and without axis, legend, title and padding.
+// Set the type of series to bar for bar sparkline, and set FillArea for area sparkline.
+func SparklineRender(values []float64, opts ...OptionFunc) (*Painter, error) {
+	opt := ChartOption{
+		SeriesList: NewSeriesListDataFromValues([][]float64{
+			values,
+		}, ChartTypeLine),
+		Width:  defaultSparklineWidth,
+		Height: defaultSparklineHeight,
+	}
+	for _, fn := range opts {
+		fn(&opt)
+	}
+	// 仅使用指定的padding
+	padding := opt.Padding
+	opt.fillDefault()
+
+	p, err := NewPainter(PainterOptions{
+		Type:   opt.Type,
+		Width:  opt.Width,
+		Height: opt.Height,
+		Font:   opt.font,
+	})
+	if err != nil {
+		return nil, err
+	}
+	p.SetBackground(p.Width(), p.Height(), opt.BackgroundColor)
+	_, err = NewSparklineChart(p.Child(PainterPaddingOption(padding)), SparklineChartOption{
+		Theme:       opt.theme,
+		SeriesList:  opt.SeriesList,
+		StrokeWidth: opt.LineStrokeWidth,
+		FillArea:    opt.FillArea,
+		Opacity:     opt.Opacity,
+		Sparkline:   opt.Sparkline,
+	}).Render()
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// RadialBarRender radial bar chart render, each value is a ring,
+// the max value of ring can be set by RadialBarOptionFunc
+func RadialBarRender(values []float64, opts ...OptionFunc) (*Painter, error) {
+	return Render(ChartOption{
+		SeriesList: NewRadialBarSeriesList(values, nil),
+	}, opts...)
+}
+
+// GaugeRender gauge chart render
+func GaugeRender(value float64, opts ...OptionFunc) (*Painter, error) {
+	return Render(ChartOption{
+		SeriesList: NewGaugeSeriesList([]float64{
+			value,
+		}),
+	}, opts...)
+}
+
 // TableRender table chart render
 func TableRender(header []string, data [][]string, spanMaps ...map[int]int) (*Painter, error) {
 	opt := TableChartOption{
@@ -23,7 +23,9 @@
 package charts
 
 import (
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/wcharczuk/go-chart/v2/drawing"
@@ -204,7 +206,7 @@ func TestLineRender(t *testing.T) {
 	assert.Nil(err)
 	data, err := p.Bytes()
 	assert.Nil(err)
-	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 20 29\nL 50 29\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><circle cx=\"35\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"52\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Email</text><path  d=\"M 111 29\nL 141 29\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><circle cx=\"126\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"143\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Union Ads</text><path  d=\"M 234 29\nL 264 29\" style=\"stroke-width:3;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><circle cx=\"249\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><text x=\"266\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Video Ads</text><path  d=\"M 357 29\nL 387 29\" style=\"stroke-width:3;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><circle cx=\"372\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><text x=\"389\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Direct</text><path  d=\"M 450 29\nL 480 29\" style=\"stroke-width:3;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><circle cx=\"465\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><text x=\"482\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Search Engine</text><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><text x=\"20\" y=\"62\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.44k</text><text x=\"29\" y=\"111\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.2k</text><text x=\"32\" y=\"160\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">960</text><text x=\"32\" y=\"209\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">720</text><text x=\"32\" y=\"258\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">480</text><text x=\"32\" y=\"307\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">240</text><text x=\"50\" y=\"357\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path  d=\"M 69 55\nL 580 55\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 69 104\nL 580 104\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 69 153\nL 580 153\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 69 202\nL 580 202\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 69 251\nL 580 251\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 69 300\nL 580 300\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 69 355\nL 69 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 142 355\nL 142 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 215 355\nL 215 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 288 355\nL 288 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 361 355\nL 361 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 434 355\nL 434 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 507 355\nL 507 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 580 355\nL 580 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 69 350\nL 580 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"90\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"165\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"236\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"311\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"388\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"459\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"530\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path  d=\"M 105 326\nL 178 323\nL 251 330\nL 324 323\nL 397 332\nL 470 303\nL 543 307\" style=\"stroke-width:2;stroke:rgba(84,112,198,1.0);fill:none\"/><circle cx=\"105\" cy=\"326\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"178\" cy=\"323\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"251\" cy=\"330\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"324\" cy=\"323\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"397\" cy=\"332\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"470\" cy=\"303\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"543\" cy=\"307\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"M 105 305\nL 178 313\nL 251 311\nL 324 303\nL 397 291\nL 470 283\nL 543 287\" style=\"stroke-width:2;stroke:rgba(145,204,117,1.0);fill:none\"/><circle cx=\"105\" cy=\"305\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"178\" cy=\"313\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"251\" cy=\"311\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"324\" cy=\"303\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"397\" cy=\"291\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"470\" cy=\"283\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"543\" cy=\"287\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"M 105 320\nL 178 303\nL 251 309\nL 324 319\nL 397 312\nL 470 283\nL 543 267\" style=\"stroke-width:2;stroke:rgba(250,200,88,1.0);fill:none\"/><circle cx=\"105\" cy=\"320\" r=\"2\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"178\" cy=\"303\" r=\"2\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"251\" cy=\"309\" r=\"2\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"324\" cy=\"319\" r=\"2\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"397\" cy=\"312\" r=\"2\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"470\" cy=\"283\" r=\"2\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"543\" cy=\"267\" r=\"2\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"M 105 285\nL 178 282\nL 251 289\nL 324 282\nL 397 271\nL 470 283\nL 543 285\" style=\"stroke-width:2;stroke:rgba(238,102,102,1.0);fill:none\"/><circle cx=\"105\" cy=\"285\" r=\"2\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"178\" cy=\"282\" r=\"2\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"251\" cy=\"289\" r=\"2\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"324\" cy=\"282\" r=\"2\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"397\" cy=\"271\" r=\"2\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"470\" cy=\"283\" r=\"2\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"543\" cy=\"285\" r=\"2\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"M 105 183\nL 178 160\nL 251 166\nL 324 159\nL 397 86\nL 470 78\nL 543 80\" style=\"stroke-width:2;stroke:rgba(115,192,222,1.0);fill:none\"/><circle cx=\"105\" cy=\"183\" r=\"2\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"178\" cy=\"160\" r=\"2\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"251\" cy=\"166\" r=\"2\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"324\" cy=\"159\" r=\"2\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"397\" cy=\"86\" r=\"2\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"470\" cy=\"78\" r=\"2\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"543\" cy=\"80\" r=\"2\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(255,255,255,1.0)\"/></svg>", string(data))
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 20 29\nL 50 29\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><circle cx=\"35\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"52\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Email</text><path  d=\"M 111 29\nL 141 29\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><circle cx=\"126\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"143\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Union Ads</text><path  d=\"M 234 29\nL 264 29\" style=\"stroke-width:3;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><circle cx=\"249\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><text x=\"266\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Video Ads</text><path  d=\"M 357 29\nL 387 29\" style=\"stroke-width:3;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><circle cx=\"372\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><text x=\"389\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Direct</text><path  d=\"M 450 29\nL 480 29\" style=\"stroke-width:3;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><circle cx=\"465\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><text x=\"482\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Search Engine</text><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Line</text><text x=\"20\" y=\"62\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.4k</text><text x=\"20\" y=\"104\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1.2k</text><text x=\"33\" y=\"146\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1k</text><text x=\"23\" y=\"188\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">800</text><text x=\"23\" y=\"230\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">600</text><text x=\"23\" y=\"272\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">400</text><text x=\"23\" y=\"314\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">200</text><text x=\"41\" y=\"357\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path  d=\"M 60 55\nL 580 55\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 60 97\nL 580 97\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 60 139\nL 580 139\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 60 181\nL 580 181\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 60 223\nL 580 223\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 60 265\nL 580 265\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 60 307\nL 580 307\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 60 355\nL 60 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 134 355\nL 134 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 208 355\nL 208 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 282 355\nL 282 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 357 355\nL 357 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 431 355\nL 431 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 505 355\nL 505 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 580 355\nL 580 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 60 350\nL 580 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"82\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"158\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"230\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"306\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Thu</text><text x=\"385\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"457\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sat</text><text x=\"529\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Sun</text><path  d=\"M 97 325\nL 171 323\nL 245 329\nL 319 322\nL 394 332\nL 468 302\nL 542 306\" style=\"stroke-width:2;stroke:rgba(84,112,198,1.0);fill:none\"/><circle cx=\"97\" cy=\"325\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"171\" cy=\"323\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"245\" cy=\"329\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"319\" cy=\"322\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"394\" cy=\"332\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"468\" cy=\"302\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"542\" cy=\"306\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"M 97 304\nL 171 312\nL 245 310\nL 319 301\nL 394 289\nL 468 281\nL 542 285\" style=\"stroke-width:2;stroke:rgba(145,204,117,1.0);fill:none\"/><circle cx=\"97\" cy=\"304\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"171\" cy=\"312\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"245\" cy=\"310\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"319\" cy=\"301\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"394\" cy=\"289\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"468\" cy=\"281\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"542\" cy=\"285\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"M 97 319\nL 171 302\nL 245 308\nL 319 318\nL 394 310\nL 468 281\nL 542 264\" style=\"stroke-width:2;stroke:rgba(250,200,88,1.0);fill:none\"/><circle cx=\"97\" cy=\"319\" r=\"2\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"171\" cy=\"302\" r=\"2\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"245\" cy=\"308\" r=\"2\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"319\" cy=\"318\" r=\"2\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"394\" cy=\"310\" r=\"2\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"468\" cy=\"281\" r=\"2\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"542\" cy=\"264\" r=\"2\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"M 97 283\nL 171 281\nL 245 287\nL 319 280\nL 394 268\nL 468 281\nL 542 283\" style=\"stroke-width:2;stroke:rgba(238,102,102,1.0);fill:none\"/><circle cx=\"97\" cy=\"283\" r=\"2\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"171\" cy=\"281\" r=\"2\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"245\" cy=\"287\" r=\"2\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"319\" cy=\"280\" r=\"2\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"394\" cy=\"268\" r=\"2\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"468\" cy=\"281\" r=\"2\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"542\" cy=\"283\" r=\"2\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"M 97 178\nL 171 154\nL 245 161\nL 319 154\nL 394 79\nL 468 70\nL 542 72\" style=\"stroke-width:2;stroke:rgba(115,192,222,1.0);fill:none\"/><circle cx=\"97\" cy=\"178\" r=\"2\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"171\" cy=\"154\" r=\"2\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"245\" cy=\"161\" r=\"2\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"319\" cy=\"154\" r=\"2\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"394\" cy=\"79\" r=\"2\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"468\" cy=\"70\" r=\"2\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"542\" cy=\"72\" r=\"2\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(255,255,255,1.0)\"/></svg>", string(data))
 }
 
 func TestBarRender(t *testing.T) {
@@ -277,7 +279,868 @@ func TestBarRender(t *testing.T) {
 	assert.Nil(err)
 	data, err := p.Bytes()
 	assert.Nil(err)
-	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 364 29\nL 394 29\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><circle cx=\"379\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"396\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Rainfall</text><path  d=\"M 468 29\nL 498 29\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><circle cx=\"483\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"500\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Evaporation</text><text x=\"20\" y=\"27\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">240</text><text x=\"20\" y=\"82\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">200</text><text x=\"20\" y=\"137\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"20\" y=\"192\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"29\" y=\"247\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">80</text><text x=\"29\" y=\"302\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"38\" y=\"357\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path  d=\"M 57 20\nL 580 20\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 75\nL 580 75\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 130\nL 580 130\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 185\nL 580 185\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 240\nL 580 240\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 295\nL 580 295\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 355\nL 57 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 187 355\nL 187 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 318 355\nL 318 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 449 355\nL 449 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 580 355\nL 580 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 57 350\nL 580 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"109\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"237\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"369\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Aug</text><text x=\"500\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Nov</text><path  d=\"M 62 348\nL 77 348\nL 77 349\nL 62 349\nL 62 348\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 105 344\nL 120 344\nL 120 349\nL 105 349\nL 105 344\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 149 341\nL 164 341\nL 164 349\nL 149 349\nL 149 341\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 192 319\nL 207 319\nL 207 349\nL 192 349\nL 192 319\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 236 315\nL 251 315\nL 251 349\nL 236 349\nL 236 315\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 279 245\nL 294 245\nL 294 349\nL 279 349\nL 279 245\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 323 164\nL 338 164\nL 338 349\nL 323 349\nL 323 164\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 367 127\nL 382 127\nL 382 349\nL 367 349\nL 367 127\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 410 306\nL 425 306\nL 425 349\nL 410 349\nL 410 306\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 454 323\nL 469 323\nL 469 349\nL 454 349\nL 454 323\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 497 342\nL 512 342\nL 512 349\nL 497 349\nL 497 342\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 541 346\nL 556 346\nL 556 349\nL 541 349\nL 541 346\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 80 347\nL 95 347\nL 95 349\nL 80 349\nL 80 347\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 123 342\nL 138 342\nL 138 349\nL 123 349\nL 123 342\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 167 338\nL 182 338\nL 182 349\nL 167 349\nL 167 338\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 210 314\nL 225 314\nL 225 349\nL 210 349\nL 210 314\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 254 311\nL 269 311\nL 269 349\nL 254 349\nL 254 311\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 297 253\nL 312 253\nL 312 349\nL 297 349\nL 297 253\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 341 109\nL 356 109\nL 356 349\nL 341 349\nL 341 109\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 385 100\nL 400 100\nL 400 349\nL 385 349\nL 385 100\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 428 284\nL 443 284\nL 443 349\nL 428 349\nL 428 284\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 472 325\nL 487 325\nL 487 349\nL 472 349\nL 472 325\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 515 342\nL 530 342\nL 530 349\nL 515 349\nL 515 342\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 559 347\nL 574 347\nL 574 349\nL 559 349\nL 559 347\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 371 119\nA 15 15 330.00 1 1 377 119\nL 374 105\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 359 105\nQ374,142 389,105\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><text x=\"361\" y=\"110\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">162.2</text><path  d=\"M 66 340\nA 15 15 330.00 1 1 72 340\nL 69 326\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 54 326\nQ69,363 84,326\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><text x=\"65\" y=\"331\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><path  d=\"M 389 92\nA 15 15 330.00 1 1 395 92\nL 392 78\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 377 78\nQ392,115 407,78\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><text x=\"379\" y=\"83\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">182.2</text><path  d=\"M 563 339\nA 15 15 330.00 1 1 569 339\nL 566 325\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 551 325\nQ566,362 581,325\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><text x=\"557\" y=\"330\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text><circle cx=\"60\" cy=\"293\" r=\"3\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 66 293\nL 562 293\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 288\nL 578 293\nL 562 298\nL 567 293\nL 562 288\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"580\" y=\"297\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">41.62</text><circle cx=\"60\" cy=\"284\" r=\"3\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 66 284\nL 562 284\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 279\nL 578 284\nL 562 289\nL 567 284\nL 562 279\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"580\" y=\"288\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.07</text></svg>", string(data))
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 364 29\nL 394 29\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><circle cx=\"379\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"396\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Rainfall</text><path  d=\"M 468 29\nL 498 29\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><circle cx=\"483\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"500\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Evaporation</text><text x=\"20\" y=\"27\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">200</text><text x=\"20\" y=\"109\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">150</text><text x=\"20\" y=\"192\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><text x=\"29\" y=\"274\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">50</text><text x=\"38\" y=\"357\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path  d=\"M 57 20\nL 580 20\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 102\nL 580 102\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 185\nL 580 185\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 267\nL 580 267\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 355\nL 57 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 187 355\nL 187 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 318 355\nL 318 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 449 355\nL 449 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 580 355\nL 580 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 57 350\nL 580 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"109\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"237\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"369\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Aug</text><text x=\"500\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Nov</text><path  d=\"M 62 347\nL 77 347\nL 77 349\nL 62 349\nL 62 347\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 105 342\nL 120 342\nL 120 349\nL 105 349\nL 105 342\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 149 339\nL 164 339\nL 164 349\nL 149 349\nL 149 339\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 192 312\nL 207 312\nL 207 349\nL 192 349\nL 192 312\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 236 308\nL 251 308\nL 251 349\nL 236 349\nL 236 308\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 279 224\nL 294 224\nL 294 349\nL 279 349\nL 279 224\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 323 127\nL 338 127\nL 338 349\nL 323 349\nL 323 127\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 367 83\nL 382 83\nL 382 349\nL 367 349\nL 367 83\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 410 297\nL 425 297\nL 425 349\nL 410 349\nL 410 297\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 454 317\nL 469 317\nL 469 349\nL 454 349\nL 454 317\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 497 340\nL 512 340\nL 512 349\nL 497 349\nL 497 340\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 541 345\nL 556 345\nL 556 349\nL 541 349\nL 541 345\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 80 346\nL 95 346\nL 95 349\nL 80 349\nL 80 346\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 123 341\nL 138 341\nL 138 349\nL 123 349\nL 123 341\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 167 336\nL 182 336\nL 182 349\nL 167 349\nL 167 336\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 210 307\nL 225 307\nL 225 349\nL 210 349\nL 210 307\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 254 303\nL 269 303\nL 269 349\nL 254 349\nL 254 303\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 297 234\nL 312 234\nL 312 349\nL 297 349\nL 297 234\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 341 61\nL 356 61\nL 356 349\nL 341 349\nL 341 61\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 385 50\nL 400 50\nL 400 349\nL 385 349\nL 385 50\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 428 270\nL 443 270\nL 443 349\nL 428 349\nL 428 270\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 472 319\nL 487 319\nL 487 349\nL 472 349\nL 472 319\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 515 341\nL 530 341\nL 530 349\nL 515 349\nL 515 341\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 559 347\nL 574 347\nL 574 349\nL 559 349\nL 559 347\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 371 75\nA 15 15 330.00 1 1 377 75\nL 374 61\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 359 61\nQ374,98 389,61\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><text x=\"361\" y=\"66\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">162.2</text><path  d=\"M 66 339\nA 15 15 330.00 1 1 72 339\nL 69 325\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 54 325\nQ69,362 84,325\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><text x=\"65\" y=\"330\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><path  d=\"M 389 42\nA 15 15 330.00 1 1 395 42\nL 392 28\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 377 28\nQ392,65 407,28\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><text x=\"379\" y=\"33\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">182.2</text><path  d=\"M 563 339\nA 15 15 330.00 1 1 569 339\nL 566 325\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 551 325\nQ566,362 581,325\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><text x=\"557\" y=\"330\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text><circle cx=\"60\" cy=\"282\" r=\"3\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 66 282\nL 562 282\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 277\nL 578 282\nL 562 287\nL 567 282\nL 562 277\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"580\" y=\"286\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">41.62</text><circle cx=\"60\" cy=\"271\" r=\"3\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 66 271\nL 562 271\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 562 266\nL 578 271\nL 562 276\nL 567 271\nL 562 266\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"580\" y=\"275\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.07</text></svg>", string(data))
+}
+
+func TestScatterRender(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := ScatterRender(
+		[][][]float64{
+			{
+				{
+					1,
+					2,
+				},
+				{
+					3,
+					5,
+				},
+				{
+					6,
+					4,
+				},
+			},
+		},
+		SVGTypeOption(),
+		TitleTextOptionFunc("Scatter"),
+		func(opt *ChartOption) {
+			opt.SymbolSize = 12
+		},
+	)
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Scatter</text><text x=\"20\" y=\"62\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5.0</text><text x=\"20\" y=\"111\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4.5</text><text x=\"20\" y=\"160\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4.0</text><text x=\"20\" y=\"209\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3.5</text><text x=\"20\" y=\"258\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3.0</text><text x=\"20\" y=\"307\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2.5</text><text x=\"20\" y=\"357\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2.0</text><path  d=\"M 52 55\nL 580 55\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 52 104\nL 580 104\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 52 153\nL 580 153\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 52 202\nL 580 202\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 52 251\nL 580 251\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 52 300\nL 580 300\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><text x=\"48\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><text x=\"153\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"259\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">3</text><text x=\"364\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"470\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"576\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><path  d=\"M 157 55\nL 157 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 263 55\nL 263 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 368 55\nL 368 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 474 55\nL 474 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 580 55\nL 580 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><circle cx=\"52\" cy=\"350\" r=\"6\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,0.8)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,0.8)\"/><circle cx=\"263\" cy=\"55\" r=\"6\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,0.8)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,0.8)\"/><circle cx=\"580\" cy=\"154\" r=\"6\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,0.8)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,0.8)\"/></svg>", string(data))
+	// 时间轴按时间定位散点
+	start := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	p, err = ScatterRender(
+		[][][]float64{
+			{
+				{
+					0,
+					2,
+				},
+				{
+					0,
+					5,
+				},
+				{
+					0,
+					4,
+				},
+			},
+		},
+		SVGTypeOption(),
+		XAxisTimeOptionFunc([]time.Time{
+			start,
+			start.Add(time.Hour),
+			start.Add(2 * time.Hour),
+		}),
+	)
+	assert.Nil(err)
+	data, err = p.Bytes()
+	assert.Nil(err)
+	assert.Equal([]string{
+		`<circle cx="52" cy="350"`,
+		`<circle cx="316" cy="20"`,
+		`<circle cx="580" cy="130"`,
+	}, regexp.MustCompile(`<circle cx="-?\d+" cy="-?\d+"`).FindAllString(string(data), -1))
+}
+
+func TestCandlestickRender(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := CandlestickRender(
+		[][][]float64{
+			{
+				{
+					20,
+					34,
+					10,
+					38,
+				},
+				{
+					40,
+					35,
+					30,
+					50,
+				},
+			},
+		},
+		SVGTypeOption(),
+		TitleTextOptionFunc("Candlestick"),
+		XAxisDataOptionFunc([]string{
+			"Mon",
+			"Tue",
+		}),
+	)
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Candlestick</text><text x=\"20\" y=\"62\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">50</text><text x=\"20\" y=\"135\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"20\" y=\"209\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">30</text><text x=\"20\" y=\"283\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"20\" y=\"357\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">10</text><path  d=\"M 48 55\nL 580 55\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 48 128\nL 580 128\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 48 202\nL 580 202\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 48 276\nL 580 276\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 48 355\nL 48 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 314 355\nL 314 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 580 355\nL 580 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 48 350\nL 580 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"166\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"434\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><path  d=\"M 181 144\nL 181 350\" style=\"stroke-width:1;stroke:rgba(235,84,84,1.0);fill:none\"/><path  d=\"M 58 173\nL 304 173\nL 304 277\nL 58 277\nL 58 173\" style=\"stroke-width:0;stroke:none;fill:rgba(235,84,84,1.0)\"/><path  d=\"M 447 55\nL 447 203\" style=\"stroke-width:1;stroke:rgba(71,178,98,1.0);fill:none\"/><path  d=\"M 324 129\nL 570 129\nL 570 166\nL 324 166\nL 324 129\" style=\"stroke-width:0;stroke:none;fill:rgba(71,178,98,1.0)\"/></svg>", string(data))
+}
+
+func TestHeatmapRender(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := HeatmapRender(
+		[][]float64{
+			{
+				1,
+				2,
+			},
+			{
+				3,
+				4,
+			},
+		},
+		SVGTypeOption(),
+		TitleTextOptionFunc("Heatmap"),
+		XAxisDataOptionFunc([]string{
+			"A",
+			"B",
+		}),
+		YAxisDataOptionFunc([]string{
+			"Mon",
+			"Tue",
+		}),
+		VisualMapOptionFunc(VisualMapOption{
+			ItemHeight: 10,
+		}),
+	)
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Heatmap</text><text x=\"566\" y=\"192\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"566\" y=\"227\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path  d=\"M 560 197\nL 580 197\nL 580 199\nL 560 199\nL 560 197\" style=\"stroke-width:0;stroke:none;fill:rgba(191,68,76,1.0)\"/><path  d=\"M 560 199\nL 580 199\nL 580 201\nL 560 201\nL 560 199\" style=\"stroke-width:0;stroke:none;fill:rgba(201,93,92,1.0)\"/><path  d=\"M 560 201\nL 580 201\nL 580 203\nL 560 203\nL 560 201\" style=\"stroke-width:0;stroke:none;fill:rgba(211,118,107,1.0)\"/><path  d=\"M 560 203\nL 580 203\nL 580 205\nL 560 205\nL 560 203\" style=\"stroke-width:0;stroke:none;fill:rgba(222,152,125,1.0)\"/><path  d=\"M 560 205\nL 580 205\nL 580 207\nL 560 207\nL 560 205\" style=\"stroke-width:0;stroke:none;fill:rgba(234,195,146,1.0)\"/><path  d=\"M 56 55\nL 61 55\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 56 202\nL 61 202\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 56 350\nL 61 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 61 55\nL 61 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"24\" y=\"135\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"20\" y=\"283\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><path  d=\"M 61 355\nL 61 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 305 355\nL 305 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 550 355\nL 550 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 61 350\nL 550 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"178\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"422\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path  d=\"M 61 202\nL 305 202\nL 305 350\nL 61 350\nL 61 202\" style=\"stroke-width:0;stroke:none;fill:rgba(246,239,166,1.0)\"/><path  d=\"M 305 202\nL 550 202\nL 550 350\nL 305 350\nL 305 202\" style=\"stroke-width:0;stroke:none;fill:rgba(226,166,132,1.0)\"/><path  d=\"M 61 55\nL 305 55\nL 305 202\nL 61 202\nL 61 55\" style=\"stroke-width:0;stroke:none;fill:rgba(208,109,102,1.0)\"/><path  d=\"M 305 55\nL 550 55\nL 550 202\nL 305 202\nL 305 55\" style=\"stroke-width:0;stroke:none;fill:rgba(191,68,76,1.0)\"/></svg>", string(data))
+}
+
+func TestLogAxisRender(t *testing.T) {
+	assert := assert.New(t)
+
+	values := [][]float64{
+		{
+			1.2,
+			12,
+			160,
+			3200,
+		},
+	}
+	logAxisOptionFunc := YAxisOptionFunc(YAxisOption{
+		Type: AxisTypeLog,
+	})
+	p, err := LineRender(
+		values,
+		SVGTypeOption(),
+		XAxisDataOptionFunc([]string{
+			"p10",
+			"p50",
+			"p90",
+			"p99",
+		}),
+		logAxisOptionFunc,
+	)
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"21\" y=\"27\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">10k</text><text x=\"30\" y=\"109\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1k</text><text x=\"20\" y=\"192\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><text x=\"29\" y=\"274\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">10</text><text x=\"38\" y=\"357\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path  d=\"M 57 20\nL 580 20\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 102\nL 580 102\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 185\nL 580 185\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 267\nL 580 267\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 355\nL 57 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 187 355\nL 187 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 318 355\nL 318 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 449 355\nL 449 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 580 355\nL 580 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 57 350\nL 580 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"109\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">p10</text><text x=\"239\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">p50</text><text x=\"370\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">p90</text><text x=\"501\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">p99</text><path  d=\"M 122 344\nL 252 261\nL 383 169\nL 514 61\" style=\"stroke-width:2;stroke:rgba(84,112,198,1.0);fill:none\"/><circle cx=\"122\" cy=\"344\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"252\" cy=\"261\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"383\" cy=\"169\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"514\" cy=\"61\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/></svg>", string(data))
+
+	// 对数轴不支持非正数
+	values[0][0] = 0
+	_, err = LineRender(values, logAxisOptionFunc)
+	assert.Equal("The value of log axis should be > 0, but got 0", err.Error())
+}
+
+func TestGaugeRender(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := GaugeRender(
+		72.5,
+		SVGTypeOption(),
+		TitleTextOptionFunc("Speed"),
+		GaugeOptionFunc(GaugeOption{
+			SplitNumber: 5,
+		}),
+	)
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Speed</text><path  d=\"M 221 281\nA 113 113 81.00 0 1 209 136\" style=\"stroke-width:9;stroke:rgba(145,204,117,1.0);fill:none\"/><path  d=\"M 209 136\nA 113 113 108.00 0 1 391 136\" style=\"stroke-width:9;stroke:rgba(250,200,88,1.0);fill:none\"/><path  d=\"M 391 136\nA 113 113 81.00 0 1 379 281\" style=\"stroke-width:9;stroke:rgba(238,102,102,1.0);fill:none\"/><path  d=\"M 225 277\nL 231 271\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"234\" y=\"270\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">0</text><path  d=\"M 196 186\nL 203 187\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"206\" y=\"195\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><path  d=\"M 252 108\nL 256 115\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"253\" y=\"130\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40</text><path  d=\"M 348 108\nL 344 115\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"333\" y=\"130\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">60</text><path  d=\"M 404 186\nL 397 187\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"380\" y=\"195\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text><path  d=\"M 375 277\nL 369 271\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"351\" y=\"270\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">100</text><path  d=\"M 361 168\nL 299 199\nL 294 205\nL 301 205\nL 361 168\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><circle cx=\"300\" cy=\"202\" r=\"5\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><text x=\"277\" y=\"298\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:23.0px;font-family:'Roboto Medium',sans-serif\">72.5</text></svg>", string(data))
+
+	_, err = Render(ChartOption{
+		SeriesList: append(NewGaugeSeriesList([]float64{
+			10,
+		}), NewSeriesFromValues([]float64{
+			1,
+		}, ChartTypeLine)),
+	})
+	assert.Equal("Gauge can not mix other charts", err.Error())
+}
+
+func TestDonutRender(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := PieRender(
+		[]float64{
+			1048,
+			735,
+			580,
+			484,
+			300,
+		},
+		SVGTypeOption(),
+		TitleTextOptionFunc("Donut"),
+		LegendLabelsOptionFunc([]string{
+			"Search Engine",
+			"Direct",
+			"Email",
+			"Union Ads",
+			"Video Ads",
+		}),
+		PieSeriesShowLabel(),
+		PieSeriesRadius("20%", "35%"),
+		PieSeriesRoseType(PieRoseTypeRadius),
+		PieSeriesCenterLabel("Total\n{c}"),
+	)
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 20 29\nL 50 29\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><circle cx=\"35\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"52\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Search Engine</text><path  d=\"M 171 29\nL 201 29\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><circle cx=\"186\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"203\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Direct</text><path  d=\"M 264 29\nL 294 29\" style=\"stroke-width:3;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><circle cx=\"279\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><text x=\"296\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Email</text><path  d=\"M 355 29\nL 385 29\" style=\"stroke-width:3;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><circle cx=\"370\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><text x=\"387\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Union Ads</text><path  d=\"M 478 29\nL 508 29\" style=\"stroke-width:3;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><circle cx=\"493\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><text x=\"510\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Video Ads</text><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Donut</text><path  d=\"M 300 99\nA 103 103 119.89 0 1 389 253\nL 351 231\nL 352 229\nL 353 227\nL 353 225\nL 354 223\nL 355 222\nL 356 220\nL 356 218\nL 357 216\nL 357 214\nL 358 212\nL 358 210\nL 358 208\nL 358 206\nL 358 203\nL 358 202\nL 358 200\nL 358 198\nL 358 196\nL 358 194\nL 358 192\nL 357 190\nL 357 188\nL 356 186\nL 356 184\nL 355 182\nL 354 180\nL 353 178\nL 353 177\nL 352 175\nL 351 173\nL 350 171\nL 348 169\nL 347 168\nL 346 166\nL 345 165\nL 343 163\nL 342 161\nL 340 160\nL 339 159\nL 337 157\nL 336 156\nL 334 155\nL 332 154\nL 331 152\nL 329 151\nL 327 150\nL 325 149\nL 323 149\nL 322 148\nL 320 147\nL 318 146\nL 316 146\nL 314 145\nL 312 145\nL 310 144\nL 308 144\nL 306 144\nL 304 144\nL 302 144\nL 300 143\nZ\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"M 389 151\nL 402 143\nM 402 143\nL 417 143\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"420\" y=\"148\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Search Engine: 33.3%</text><path  d=\"M 378 246\nA 90 90 84.08 0 1 264 284\nL 277 255\nL 278 256\nL 280 257\nL 282 258\nL 284 258\nL 286 259\nL 288 259\nL 290 260\nL 292 260\nL 294 260\nL 296 260\nL 298 260\nL 300 260\nL 301 260\nL 303 260\nL 305 260\nL 307 260\nL 309 260\nL 311 259\nL 313 259\nL 315 258\nL 317 258\nL 319 257\nL 321 257\nL 323 256\nL 324 255\nL 326 254\nL 328 253\nL 330 252\nL 331 251\nL 333 250\nL 335 249\nL 336 248\nL 338 246\nL 339 245\nL 341 244\nL 342 242\nL 344 241\nL 345 239\nL 346 238\nL 347 236\nL 349 234\nL 350 233\nL 351 231\nZ\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"M 327 287\nL 332 301\nM 332 301\nL 347 301\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"350\" y=\"306\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Direct: 23.35%</text><path  d=\"M 267 278\nA 83 83 66.35 0 1 217 202\nL 242 202\nL 242 203\nL 242 205\nL 242 207\nL 242 209\nL 242 211\nL 243 213\nL 243 215\nL 244 217\nL 244 219\nL 245 221\nL 245 223\nL 246 225\nL 247 226\nL 248 228\nL 249 230\nL 250 232\nL 251 234\nL 252 235\nL 253 237\nL 254 238\nL 256 240\nL 257 241\nL 258 243\nL 260 244\nL 261 246\nL 263 247\nL 264 248\nL 266 249\nL 268 251\nL 269 252\nL 271 253\nL 273 254\nL 275 255\nL 277 255\nZ\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><path  d=\"M 230 247\nL 218 255\nM 218 255\nL 203 255\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><text x=\"119\" y=\"260\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Email: 18.43%</text><path  d=\"M 221 202\nA 79 79 55.37 0 1 256 137\nL 267 154\nL 266 155\nL 264 156\nL 262 157\nL 261 159\nL 259 160\nL 258 162\nL 257 163\nL 255 165\nL 254 166\nL 253 168\nL 252 170\nL 250 171\nL 249 173\nL 248 175\nL 247 177\nL 247 178\nL 246 180\nL 245 182\nL 244 184\nL 244 186\nL 243 188\nL 243 190\nL 242 192\nL 242 194\nL 242 196\nL 242 198\nL 242 200\nL 242 202\nZ\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><path  d=\"M 230 165\nL 217 158\nM 217 158\nL 202 158\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><text x=\"91\" y=\"163\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Union Ads: 15.37%</text><path  d=\"M 260 143\nA 71 71 34.32 0 1 300 131\nL 300 143\nL 299 144\nL 297 144\nL 295 144\nL 293 144\nL 291 144\nL 289 145\nL 287 145\nL 285 146\nL 283 146\nL 281 147\nL 279 147\nL 278 148\nL 276 149\nL 274 150\nL 272 151\nL 271 152\nL 269 153\nL 267 154\nZ\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><path  d=\"M 279 134\nL 275 120\nM 275 120\nL 260 120\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><text x=\"157\" y=\"125\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Video Ads: 9.53%</text><text x=\"273\" y=\"197\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:23.0px;font-family:'Roboto Medium',sans-serif\">Total</text><text x=\"274\" y=\"225\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:23.0px;font-family:'Roboto Medium',sans-serif\">3147</text></svg>", string(data))
+}
+
+func TestBoxPlotRender(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := BoxPlotRender(
+		[][][]float64{
+			{
+				{
+					20, 40, 50, 60, 90,
+				},
+				{
+					30, 55, 70, 80, 110,
+				},
+			},
+		},
+		SVGTypeOption(),
+		TitleTextOptionFunc("Box Plot"),
+		XAxisDataOptionFunc([]string{
+			"A",
+			"B",
+		}),
+	)
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Box Plot</text><text x=\"20\" y=\"62\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"20\" y=\"121\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><text x=\"29\" y=\"180\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">80</text><text x=\"29\" y=\"239\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">60</text><text x=\"29\" y=\"298\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"29\" y=\"357\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">20</text><path  d=\"M 57 55\nL 580 55\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 114\nL 580 114\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 173\nL 580 173\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 232\nL 580 232\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 291\nL 580 291\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 355\nL 57 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 318 355\nL 318 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 580 355\nL 580 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 57 350\nL 580 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"182\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"444\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><path  d=\"M 187 144\nL 187 232\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 187 291\nL 187 350\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 127 144\nL 247 144\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 127 350\nL 247 350\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 67 232\nL 308 232\nL 308 291\nL 67 291\nZ\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,0.2)\"/><path  d=\"M 67 262\nL 308 262\" style=\"stroke-width:2;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 448 85\nL 448 173\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 448 247\nL 448 321\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 388 85\nL 508 85\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 388 321\nL 508 321\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 328 173\nL 569 173\nL 569 247\nL 328 247\nZ\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,0.2)\"/><path  d=\"M 328 203\nL 569 203\" style=\"stroke-width:2;stroke:rgba(84,112,198,1.0);fill:none\"/></svg>", string(data))
+}
+
+func TestHistogramRender(t *testing.T) {
+	assert := assert.New(t)
+
+	samples := []float64{
+		62, 75, 81, 88, 92, 95, 98, 101, 103, 104,
+		107, 110, 112, 113, 115, 118, 121, 124, 126, 129,
+		133, 137, 142, 148, 155, 163, 171, 186,
+	}
+	p, err := HistogramRender(
+		samples,
+		SVGTypeOption(),
+		TitleTextOptionFunc("Latency"),
+	)
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Latency</text><text x=\"20\" y=\"62\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">10</text><text x=\"29\" y=\"121\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">8</text><text x=\"29\" y=\"180\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"29\" y=\"239\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"29\" y=\"298\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"29\" y=\"357\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path  d=\"M 48 55\nL 580 55\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 48 114\nL 580 114\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 48 173\nL 580 173\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 48 232\nL 580 232\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 48 291\nL 580 291\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 48 355\nL 48 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 124 355\nL 124 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 200 355\nL 200 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 276 355\nL 276 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 352 355\nL 352 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 428 355\nL 428 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 504 355\nL 504 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 580 355\nL 580 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 48 350\nL 580 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"63\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">60~80</text><text x=\"135\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">80~100</text><text x=\"207\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100~120</text><text x=\"283\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120~140</text><text x=\"359\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">140~160</text><text x=\"435\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160~180</text><text x=\"511\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">180~200</text><path  d=\"M 48 291\nL 124 291\nL 124 349\nL 48 349\nL 48 291\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 124 203\nL 200 203\nL 200 349\nL 124 349\nL 124 203\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 200 85\nL 276 85\nL 276 349\nL 200 349\nL 200 85\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 276 173\nL 352 173\nL 352 349\nL 276 349\nL 276 173\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 352 262\nL 428 262\nL 428 349\nL 352 349\nL 352 262\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 428 291\nL 504 291\nL 504 349\nL 428 349\nL 428 291\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 504 321\nL 580 321\nL 580 349\nL 504 349\nL 504 321\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/></svg>", string(data))
+
+	p, err = HistogramRender(
+		samples,
+		SVGTypeOption(),
+		TitleTextOptionFunc("Latency"),
+		HistogramOptionFunc(HistogramOption{
+			BinCount:   5,
+			Cumulative: true,
+		}),
+	)
+	assert.Nil(err)
+	data, err = p.Bytes()
+	assert.Nil(err)
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Latency</text><text x=\"542\" y=\"62\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100%</text><text x=\"542\" y=\"121\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">80%</text><text x=\"542\" y=\"180\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">60%</text><text x=\"542\" y=\"239\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">40%</text><text x=\"542\" y=\"298\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">20%</text><text x=\"542\" y=\"357\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0%</text><text x=\"20\" y=\"62\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">10</text><text x=\"29\" y=\"121\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">8</text><text x=\"29\" y=\"180\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"29\" y=\"239\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"29\" y=\"298\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2</text><text x=\"29\" y=\"357\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path  d=\"M 48 55\nL 532 55\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 48 114\nL 532 114\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 48 173\nL 532 173\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 48 232\nL 532 232\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 48 291\nL 532 291\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 48 355\nL 48 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 144 355\nL 144 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 241 355\nL 241 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 338 355\nL 338 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 435 355\nL 435 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 532 355\nL 532 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 48 350\nL 532 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"73\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">62~86</text><text x=\"165\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">86~111</text><text x=\"258\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">111~136</text><text x=\"355\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">136~161</text><text x=\"452\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">161~186</text><path  d=\"M 48 262\nL 144 262\nL 144 349\nL 48 349\nL 48 262\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 144 85\nL 241 85\nL 241 349\nL 144 349\nL 144 85\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 241 85\nL 338 85\nL 338 349\nL 241 349\nL 241 85\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 338 232\nL 435 232\nL 435 349\nL 338 349\nL 338 232\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 435 262\nL 532 262\nL 532 349\nL 435 349\nL 435 262\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 96 319\nL 192 224\nL 289 129\nL 386 87\nL 483 55\" style=\"stroke-width:2;stroke:rgba(145,204,117,1.0);fill:none\"/><circle cx=\"96\" cy=\"319\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"192\" cy=\"224\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"289\" cy=\"129\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"386\" cy=\"87\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"483\" cy=\"55\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/></svg>", string(data))
+
+	// option func只执行一次
+	count := 0
+	_, err = HistogramRender(
+		samples,
+		SVGTypeOption(),
+		func(opt *ChartOption) {
+			count++
+		},
+	)
+	assert.Nil(err)
+	assert.Equal(1, count)
+
+	_, err = HistogramRender(nil)
+	assert.Equal("The samples of histogram should not be empty", err.Error())
+}
+
+func TestTreemapRender(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := TreemapRender(
+		[]TreemapNode{
+			{
+				Name: "Asia",
+				Children: []TreemapNode{
+					{
+						Name:  "China",
+						Value: 1412,
+					},
+					{
+						Name:  "India",
+						Value: 1408,
+					},
+					{
+						Name:  "Japan",
+						Value: 125,
+					},
+				},
+			},
+			{
+				Name: "America",
+				Children: []TreemapNode{
+					{
+						Name:  "USA",
+						Value: 332,
+					},
+					{
+						Name:  "Brazil",
+						Value: 214,
+					},
+				},
+			},
+			{
+				Name:  "Europe",
+				Value: 447,
+			},
+		},
+		SVGTypeOption(),
+		TitleTextOptionFunc("Population"),
+	)
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Population</text><path  d=\"M 20 55\nL 439 55\nL 439 350\nL 20 350\nL 20 55\" style=\"stroke-width:3;stroke:rgba(255,255,255,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"24\" y=\"74\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Asia</text><path  d=\"M 24 78\nL 221 78\nL 221 346\nL 24 346\nL 24 78\" style=\"stroke-width:2;stroke:rgba(255,255,255,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"28\" y=\"97\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">China</text><path  d=\"M 221 78\nL 435 78\nL 435 324\nL 221 324\nL 221 78\" style=\"stroke-width:2;stroke:rgba(255,255,255,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"225\" y=\"97\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">India</text><path  d=\"M 221 324\nL 435 324\nL 435 346\nL 221 346\nL 221 324\" style=\"stroke-width:2;stroke:rgba(255,255,255,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"M 439 55\nL 580 55\nL 580 217\nL 439 217\nL 439 55\" style=\"stroke-width:3;stroke:rgba(255,255,255,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"443\" y=\"74\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">America</text><path  d=\"M 443 78\nL 576 78\nL 576 160\nL 443 160\nL 443 78\" style=\"stroke-width:2;stroke:rgba(255,255,255,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"447\" y=\"97\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">USA</text><path  d=\"M 443 160\nL 576 160\nL 576 213\nL 443 213\nL 443 160\" style=\"stroke-width:2;stroke:rgba(255,255,255,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"447\" y=\"179\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Brazil</text><path  d=\"M 439 217\nL 580 217\nL 580 350\nL 439 350\nL 439 217\" style=\"stroke-width:3;stroke:rgba(255,255,255,1.0);fill:rgba(250,200,88,1.0)\"/><text x=\"443\" y=\"236\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Europe</text></svg>", string(data))
+
+	_, err = Render(ChartOption{
+		SeriesList: NewPieSeriesList([]float64{
+			1,
+		}),
+		TreemapNodes: []TreemapNode{
+			{
+				Name:  "A",
+				Value: 1,
+			},
+		},
+	})
+	assert.Equal("Treemap can not mix other charts", err.Error())
+}
+
+func TestSunburstRender(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := SunburstRender(
+		[]SunburstNode{
+			{
+				Name: "Asia",
+				Children: []SunburstNode{
+					{
+						Name:  "China",
+						Value: 1412,
+					},
+					{
+						Name:  "India",
+						Value: 1408,
+					},
+				},
+			},
+			{
+				Name:  "Europe",
+				Value: 447,
+			},
+		},
+		SVGTypeOption(),
+		TitleTextOptionFunc("Population"),
+		SunburstOptionFunc(SunburstOption{
+			InnerRadius: "5%",
+		}),
+	)
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Population</text><path  d=\"M 300 136\nA 66 66 310.74 1 1 250 159\nL 289 193\nL 289 193\nL 289 194\nL 288 194\nL 288 195\nL 288 195\nL 288 195\nL 287 196\nL 287 196\nL 287 197\nL 287 197\nL 287 198\nL 286 198\nL 286 199\nL 286 199\nL 286 200\nL 286 200\nL 286 201\nL 286 201\nL 286 202\nL 286 202\nL 286 202\nL 286 202\nL 286 203\nL 286 203\nL 286 204\nL 286 204\nL 286 205\nL 286 205\nL 286 206\nL 287 206\nL 287 207\nL 287 207\nL 287 208\nL 287 208\nL 288 209\nL 288 209\nL 288 210\nL 288 210\nL 289 210\nL 289 211\nL 289 211\nL 290 212\nL 290 212\nL 290 212\nL 291 213\nL 291 213\nL 292 213\nL 292 214\nL 292 214\nL 293 214\nL 293 214\nL 294 215\nL 294 215\nL 295 215\nL 295 215\nL 296 215\nL 296 216\nL 297 216\nL 297 216\nL 298 216\nL 298 216\nL 299 216\nL 299 216\nL 300 216\nL 300 216\nL 300 216\nL 300 216\nL 301 216\nL 301 216\nL 302 216\nL 302 216\nL 303 216\nL 303 216\nL 304 216\nL 304 215\nL 305 215\nL 305 215\nL 306 215\nL 306 215\nL 307 214\nL 307 214\nL 307 214\nL 308 214\nL 308 213\nL 309 213\nL 309 213\nL 309 212\nL 310 212\nL 310 212\nL 311 211\nL 311 211\nL 311 210\nL 312 210\nL 312 210\nL 312 209\nL 312 209\nL 313 208\nL 313 208\nL 313 207\nL 313 207\nL 313 206\nL 314 206\nL 314 205\nL 314 205\nL 314 204\nL 314 204\nL 314 203\nL 314 203\nL 314 202\nL 314 202\nL 314 202\nL 314 202\nL 314 201\nL 314 201\nL 314 200\nL 314 200\nL 314 199\nL 314 199\nL 314 198\nL 314 198\nL 313 197\nL 313 197\nL 313 196\nL 313 196\nL 312 196\nL 312 195\nL 312 195\nL 312 194\nL 311 194\nL 311 193\nL 311 193\nL 310 193\nL 310 192\nL 310 192\nL 309 192\nL 309 191\nL 309 191\nL 308 191\nL 308 190\nL 307 190\nL 307 190\nL 306 189\nL 306 189\nL 305 189\nL 305 189\nL 305 189\nL 304 188\nL 304 188\nL 303 188\nL 303 188\nL 302 188\nL 302 188\nL 301 188\nL 301 188\nL 300 188\nL 300 188\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"306\" y=\"230\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(65.37,306,230)\">Asia</text><path  d=\"M 300 84\nA 118 118 155.59 0 1 348 309\nL 327 262\nL 329 261\nL 331 260\nL 333 259\nL 335 258\nL 337 256\nL 339 255\nL 341 254\nL 342 252\nL 344 251\nL 346 249\nL 348 247\nL 349 246\nL 351 244\nL 352 242\nL 353 240\nL 355 238\nL 356 236\nL 357 234\nL 358 232\nL 359 230\nL 360 228\nL 361 226\nL 362 224\nL 363 222\nL 363 219\nL 364 217\nL 364 215\nL 365 213\nL 365 210\nL 366 208\nL 366 206\nL 366 204\nL 366 202\nL 366 200\nL 366 198\nL 365 195\nL 365 193\nL 365 191\nL 364 188\nL 364 186\nL 363 184\nL 363 182\nL 362 180\nL 361 177\nL 360 175\nL 359 173\nL 358 171\nL 357 169\nL 356 167\nL 354 165\nL 353 163\nL 352 162\nL 350 160\nL 349 158\nL 347 156\nL 346 155\nL 344 153\nL 342 152\nL 340 150\nL 338 149\nL 337 147\nL 335 146\nL 333 145\nL 331 144\nL 329 143\nL 326 142\nL 324 141\nL 322 140\nL 320 139\nL 318 139\nL 316 138\nL 313 138\nL 311 137\nL 309 137\nL 306 136\nL 304 136\nL 302 136\nL 300 136\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(110,133,207,1.0)\"/><text x=\"375\" y=\"192\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(-12.20,375,192)\">China</text><path  d=\"M 348 309\nA 118 118 155.15 0 1 211 125\nL 250 159\nL 249 161\nL 247 163\nL 246 165\nL 245 167\nL 243 169\nL 242 171\nL 241 173\nL 240 175\nL 239 177\nL 238 179\nL 238 181\nL 237 183\nL 236 185\nL 236 188\nL 235 190\nL 235 192\nL 235 194\nL 234 197\nL 234 199\nL 234 201\nL 234 203\nL 234 205\nL 234 207\nL 235 210\nL 235 212\nL 235 214\nL 236 216\nL 236 219\nL 237 221\nL 238 223\nL 239 225\nL 239 227\nL 240 229\nL 241 232\nL 242 234\nL 244 236\nL 245 238\nL 246 239\nL 247 241\nL 249 243\nL 250 245\nL 252 247\nL 253 248\nL 255 250\nL 257 251\nL 259 253\nL 260 254\nL 262 256\nL 264 257\nL 266 258\nL 268 259\nL 270 260\nL 272 261\nL 274 262\nL 276 263\nL 279 264\nL 281 265\nL 283 265\nL 285 266\nL 287 267\nL 290 267\nL 292 267\nL 294 268\nL 297 268\nL 299 268\nL 300 268\nL 302 268\nL 305 268\nL 307 267\nL 309 267\nL 312 267\nL 314 266\nL 316 266\nL 318 265\nL 320 264\nL 323 264\nL 325 263\nL 327 262\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(135,155,215,1.0)\"/><text x=\"218\" y=\"271\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(323.17,218,271)\">India</text><path  d=\"M 250 159\nA 66 66 49.26 0 1 300 136\nL 300 188\nL 300 188\nL 299 188\nL 299 188\nL 298 188\nL 298 188\nL 297 188\nL 297 188\nL 296 188\nL 296 188\nL 296 189\nL 295 189\nL 295 189\nL 294 189\nL 294 189\nL 293 190\nL 293 190\nL 292 190\nL 292 190\nL 292 191\nL 291 191\nL 291 191\nL 290 192\nL 290 192\nL 290 192\nL 289 193\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"269\" y=\"149\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(65.37,269,149)\">Europe</text></svg>", string(data))
+
+	_, err = SunburstRender([]SunburstNode{
+		{
+			Name: "A",
+		},
+	})
+	assert.Equal("The sum value of sunburst chart should gt 0", err.Error())
+
+	_, err = Render(ChartOption{
+		SeriesList: NewPieSeriesList([]float64{
+			1,
+		}),
+		SunburstNodes: []SunburstNode{
+			{
+				Name:  "A",
+				Value: 1,
+			},
+		},
+	})
+	assert.Equal("Sunburst can not mix other charts", err.Error())
+}
+
+func TestSankeyRender(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := SankeyRender(
+		[]SankeyNode{
+			{
+				Name: "visit",
+			},
+			{
+				Name: "signup",
+			},
+			{
+				Name: "bounce",
+			},
+			{
+				Name: "order",
+			},
+		},
+		[]SankeyLink{
+			{
+				Source: "visit",
+				Target: "signup",
+				Value:  300,
+			},
+			{
+				Source: "visit",
+				Target: "bounce",
+				Value:  700,
+			},
+			{
+				Source: "signup",
+				Target: "order",
+				Value:  120,
+			},
+		},
+		SVGTypeOption(),
+		TitleTextOptionFunc("Funnel"),
+		SankeyOptionFunc(SankeyOption{
+			NodeWidth: 12,
+		}),
+	)
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Funnel</text><path  d=\"M 32 262\nQ97,262 163,210\nQ228,158 294,158\nL 294 247\nQ228,247 163,298\nQ97,350 32,350\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,0.4)\"/><path  d=\"M 32 55\nQ166,55 300,66\nQ434,78 568,78\nL 568 284\nQ434,284 300,273\nQ166,262 32,262\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,0.4)\"/><path  d=\"M 306 158\nQ371,158 437,225\nQ502,292 568,292\nL 568 327\nQ502,327 437,260\nQ371,194 306,194\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,0.4)\"/><path  d=\"M 20 55\nL 32 55\nL 32 350\nL 20 350\nL 20 55\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"37\" y=\"209\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">visit</text><path  d=\"M 294 158\nL 306 158\nL 306 247\nL 294 247\nL 294 158\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"311\" y=\"209\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">signup</text><path  d=\"M 568 78\nL 580 78\nL 580 284\nL 568 284\nL 568 78\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><text x=\"512\" y=\"188\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">bounce</text><path  d=\"M 568 292\nL 580 292\nL 580 327\nL 568 327\nL 568 292\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><text x=\"526\" y=\"316\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">order</text></svg>", string(data))
+
+	_, err = SankeyRender([]SankeyNode{
+		{
+			Name: "A",
+		},
+	}, nil)
+	assert.Equal("The links of sankey should not be empty", err.Error())
+
+	_, err = Render(ChartOption{
+		SeriesList: NewPieSeriesList([]float64{
+			1,
+		}),
+		SankeyNodes: []SankeyNode{
+			{
+				Name: "A",
+			},
+		},
+	})
+	assert.Equal("Sankey can not mix other charts", err.Error())
+}
+
+func TestWaterfallRender(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := WaterfallRender(
+		[]float64{
+			120,
+			-30,
+			45,
+			0,
+			-60,
+			0,
+		},
+		[]int{
+			3,
+			5,
+		},
+		SVGTypeOption(),
+		TitleTextOptionFunc("Cash Flow"),
+		XAxisDataOptionFunc([]string{
+			"Open",
+			"Q1",
+			"Q2",
+			"H1",
+			"Q3",
+			"Close",
+		}),
+	)
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Cash Flow</text><text x=\"20\" y=\"62\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">140</text><text x=\"20\" y=\"104\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"20\" y=\"146\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><text x=\"29\" y=\"188\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">80</text><text x=\"29\" y=\"230\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">60</text><text x=\"29\" y=\"272\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"29\" y=\"314\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"38\" y=\"357\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path  d=\"M 57 55\nL 580 55\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 97\nL 580 97\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 139\nL 580 139\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 181\nL 580 181\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 223\nL 580 223\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 265\nL 580 265\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 307\nL 580 307\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 57 355\nL 57 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 144 355\nL 144 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 231 355\nL 231 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 318 355\nL 318 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 405 355\nL 405 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 492 355\nL 492 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 580 355\nL 580 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 57 350\nL 580 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"82\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Open</text><text x=\"177\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Q1</text><text x=\"264\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Q2</text><text x=\"351\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">H1</text><text x=\"438\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Q3</text><text x=\"517\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Close</text><path  d=\"M 67 98\nL 134 98\nL 134 350\nL 67 350\nL 67 98\" style=\"stroke-width:0;stroke:none;fill:rgba(71,178,98,1.0)\"/><path  d=\"M 134 98\nL 154 98\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 154 98\nL 221 98\nL 221 161\nL 154 161\nL 154 98\" style=\"stroke-width:0;stroke:none;fill:rgba(235,84,84,1.0)\"/><path  d=\"M 221 161\nL 241 161\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 241 66\nL 308 66\nL 308 161\nL 241 161\nL 241 66\" style=\"stroke-width:0;stroke:none;fill:rgba(71,178,98,1.0)\"/><path  d=\"M 308 66\nL 328 66\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 328 66\nL 395 66\nL 395 350\nL 328 350\nL 328 66\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 395 66\nL 415 66\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 415 66\nL 482 66\nL 482 192\nL 415 192\nL 415 66\" style=\"stroke-width:0;stroke:none;fill:rgba(235,84,84,1.0)\"/><path  d=\"M 482 192\nL 502 192\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 502 192\nL 569 192\nL 569 350\nL 502 350\nL 502 192\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/></svg>", string(data))
+	// 自定义增加与减少的颜色
+	p, err = WaterfallRender(
+		[]float64{
+			120,
+			-30,
+		},
+		nil,
+		SVGTypeOption(),
+		XAxisDataOptionFunc([]string{
+			"Open",
+			"Q1",
+		}),
+		WaterfallOptionFunc(WaterfallOption{
+			IncreaseColor: parseColor("#1890ff"),
+			DecreaseColor: parseColor("#faad14"),
+		}),
+	)
+	assert.Nil(err)
+	data, err = p.Bytes()
+	assert.Nil(err)
+	assert.Contains(string(data), "fill:rgba(24,144,255,1.0)")
+	assert.Contains(string(data), "fill:rgba(250,173,20,1.0)")
+	assert.NotContains(string(data), "fill:rgba(71,178,98,1.0)")
+}
+
+func TestGanttRender(t *testing.T) {
+	assert := assert.New(t)
+
+	day := func(d int) time.Time {
+		return time.Date(2022, 6, d, 0, 0, 0, 0, time.UTC)
+	}
+	p, err := GanttRender(
+		[]GanttTask{
+			{
+				Name:  "Design",
+				Start: day(1),
+				End:   day(8),
+			},
+			{
+				Name:  "Develop",
+				Start: day(6),
+				End:   day(20),
+			},
+			{
+				Name:  "Release",
+				Start: day(24),
+			},
+		},
+		SVGTypeOption(),
+		TitleTextOptionFunc("Schedule"),
+	)
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Schedule</text><path  d=\"M 81 55\nL 86 55\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 81 153\nL 86 153\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 81 251\nL 86 251\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 81 350\nL 86 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 86 55\nL 86 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"28\" y=\"111\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Design</text><text x=\"20\" y=\"209\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Develop</text><text x=\"21\" y=\"307\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Release</text><path  d=\"M 193 355\nL 193 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 343 355\nL 343 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 494 355\nL 494 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 86 350\nL 580 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"173\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">06-06</text><text x=\"323\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">06-13</text><text x=\"474\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">06-20</text><path  d=\"M 86 74\nL 236 74\nL 236 134\nL 86 134\nL 86 74\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 193 172\nL 494 172\nL 494 232\nL 193 232\nL 193 172\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 580 270\nL 610 300\nL 580 330\nL 550 300\nZ\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/></svg>", string(data))
+
+	_, err = Render(ChartOption{
+		SeriesList: append(NewSeriesListDataFromValues([][]float64{
+			{
+				1,
+			},
+		}), Series{
+			Type: ChartTypeGantt,
+		}),
+	})
+	assert.Equal("Gantt can not mix other charts", err.Error())
+}
+
+func TestCalendarRender(t *testing.T) {
+	assert := assert.New(t)
+
+	day := func(d int) time.Time {
+		return time.Date(2022, 6, d, 0, 0, 0, 0, time.UTC)
+	}
+	p, err := CalendarRender(
+		map[time.Time]float64{
+			day(1):  2,
+			day(2):  5,
+			day(8):  1,
+			day(15): 3,
+			day(30): 8,
+		},
+		SVGTypeOption(),
+		TitleTextOptionFunc("Deployments"),
+		HeightOptionFunc(200),
+	)
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"200\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 200\nL 0 200\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Deployments</text><text x=\"257\" y=\"93\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Mon</text><text x=\"257\" y=\"115\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Wed</text><text x=\"268\" y=\"137\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Fri</text><text x=\"288\" y=\"67\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Jun</text><path  d=\"M 288 105\nL 297 105\nL 297 114\nL 288 114\nL 288 105\" style=\"stroke-width:0;stroke:none;fill:rgba(187,198,233,1.0)\"/><path  d=\"M 288 116\nL 297 116\nL 297 125\nL 288 125\nL 288 116\" style=\"stroke-width:0;stroke:none;fill:rgba(135,155,215,1.0)\"/><path  d=\"M 288 127\nL 297 127\nL 297 136\nL 288 136\nL 288 127\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 288 138\nL 297 138\nL 297 147\nL 288 147\nL 288 138\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 299 72\nL 308 72\nL 308 81\nL 299 81\nL 299 72\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 299 83\nL 308 83\nL 308 92\nL 299 92\nL 299 83\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 299 94\nL 308 94\nL 308 103\nL 299 103\nL 299 94\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 299 105\nL 308 105\nL 308 114\nL 299 114\nL 299 105\" style=\"stroke-width:0;stroke:none;fill:rgba(204,212,238,1.0)\"/><path  d=\"M 299 116\nL 308 116\nL 308 125\nL 299 125\nL 299 116\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 299 127\nL 308 127\nL 308 136\nL 299 136\nL 299 127\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 299 138\nL 308 138\nL 308 147\nL 299 147\nL 299 138\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 310 72\nL 319 72\nL 319 81\nL 310 81\nL 310 72\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 310 83\nL 319 83\nL 319 92\nL 310 92\nL 310 83\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 310 94\nL 319 94\nL 319 103\nL 310 103\nL 310 94\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 310 105\nL 319 105\nL 319 114\nL 310 114\nL 310 105\" style=\"stroke-width:0;stroke:none;fill:rgba(170,183,227,1.0)\"/><path  d=\"M 310 116\nL 319 116\nL 319 125\nL 310 125\nL 310 116\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 310 127\nL 319 127\nL 319 136\nL 310 136\nL 310 127\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 310 138\nL 319 138\nL 319 147\nL 310 147\nL 310 138\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 321 72\nL 330 72\nL 330 81\nL 321 81\nL 321 72\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 321 83\nL 330 83\nL 330 92\nL 321 92\nL 321 83\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 321 94\nL 330 94\nL 330 103\nL 321 103\nL 321 94\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 321 105\nL 330 105\nL 330 114\nL 321 114\nL 321 105\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 321 116\nL 330 116\nL 330 125\nL 321 125\nL 321 116\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 321 127\nL 330 127\nL 330 136\nL 321 136\nL 321 127\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 321 138\nL 330 138\nL 330 147\nL 321 147\nL 321 138\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 332 72\nL 341 72\nL 341 81\nL 332 81\nL 332 72\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 332 83\nL 341 83\nL 341 92\nL 332 92\nL 332 83\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 332 94\nL 341 94\nL 341 103\nL 332 103\nL 332 94\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 332 105\nL 341 105\nL 341 114\nL 332 114\nL 332 105\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 332 116\nL 341 116\nL 341 125\nL 332 125\nL 332 116\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/></svg>", string(data))
+
+	_, err = Render(ChartOption{
+		SeriesList: append(NewSeriesListDataFromValues([][]float64{
+			{
+				1,
+			},
+		}), NewCalendarSeriesList(nil)...),
+	})
+	assert.Equal("Calendar can not mix other charts", err.Error())
+}
+
+func TestSparklineRender(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := SparklineRender(
+		[]float64{
+			3,
+			5,
+			4,
+			8,
+			6,
+		},
+		SVGTypeOption(),
+		SparklineOptionFunc(SparklineOption{
+			ShowLast: true,
+		}),
+	)
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"120\" height=\"30\">\\n<path  d=\"M 0 0\nL 120 0\nL 120 30\nL 0 30\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 3 27\nL 31 17\nL 60 22\nL 88 3\nL 117 13\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><circle cx=\"117\" cy=\"13\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/></svg>", string(data))
+}
+
+func TestBulletRender(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := BulletRender(
+		[]float64{
+			82,
+			64,
+		},
+		[]float64{
+			90,
+			70,
+		},
+		[][]float64{
+			{
+				60,
+				80,
+				100,
+			},
+			{
+				50,
+				75,
+				100,
+			},
+		},
+		SVGTypeOption(),
+		TitleTextOptionFunc("Quarterly KPI"),
+		YAxisDataOptionFunc([]string{
+			"NPS",
+			"Retention",
+		}),
+	)
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Quarterly KPI</text><path  d=\"M 91 55\nL 96 55\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 91 202\nL 96 202\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 91 350\nL 96 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 96 55\nL 96 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"20\" y=\"135\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Retention</text><text x=\"56\" y=\"283\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">NPS</text><text x=\"92\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"183\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"280\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"377\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">60</text><text x=\"474\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">80</text><text x=\"567\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><path  d=\"M 192 55\nL 192 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 289 55\nL 289 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 386 55\nL 386 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 483 55\nL 483 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 580 55\nL 580 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 96 231\nL 580 231\nL 580 319\nL 96 319\nL 96 231\" style=\"stroke-width:0;stroke:none;fill:rgba(237,237,237,1.0)\"/><path  d=\"M 96 231\nL 483 231\nL 483 319\nL 96 319\nL 96 231\" style=\"stroke-width:0;stroke:none;fill:rgba(213,213,213,1.0)\"/><path  d=\"M 96 231\nL 386 231\nL 386 319\nL 96 319\nL 96 231\" style=\"stroke-width:0;stroke:none;fill:rgba(190,190,190,1.0)\"/><path  d=\"M 96 261\nL 492 261\nL 492 290\nL 96 290\nL 96 261\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 530 242\nL 533 242\nL 533 308\nL 530 308\nL 530 242\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0)\"/><path  d=\"M 96 84\nL 580 84\nL 580 172\nL 96 172\nL 96 84\" style=\"stroke-width:0;stroke:none;fill:rgba(237,237,237,1.0)\"/><path  d=\"M 96 84\nL 459 84\nL 459 172\nL 96 172\nL 96 84\" style=\"stroke-width:0;stroke:none;fill:rgba(213,213,213,1.0)\"/><path  d=\"M 96 84\nL 338 84\nL 338 172\nL 96 172\nL 96 84\" style=\"stroke-width:0;stroke:none;fill:rgba(190,190,190,1.0)\"/><path  d=\"M 96 114\nL 405 114\nL 405 143\nL 96 143\nL 96 114\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 433 95\nL 436 95\nL 436 161\nL 433 161\nL 433 95\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0)\"/></svg>", string(data))
+
+	_, err = Render(ChartOption{
+		SeriesList: append(NewSeriesListDataFromValues([][]float64{
+			{
+				1,
+			},
+		}), NewBulletSeriesList([]float64{
+			1,
+		}, nil, nil)...),
+	})
+	assert.Equal("Bullet can not mix other charts", err.Error())
+}
+
+func TestPolarRender(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := BarRender(
+		[][]float64{
+			{
+				120,
+				200,
+				150,
+				80,
+			},
+		},
+		SVGTypeOption(),
+		TitleTextOptionFunc("Requests"),
+		XAxisDataOptionFunc([]string{
+			"0",
+			"6",
+			"12",
+			"18",
+		}),
+		PolarOptionFunc(PolarOption{
+			Radius: "45%",
+		}),
+	)
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Requests</text><path  d=\"M 333 202\nL 333 202\nA 33 33 180.00 0 1 267 202\nL 267 202\nA 33 33 180.00 0 1 333 202\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 366 202\nL 366 202\nA 66 66 180.00 0 1 234 202\nL 234 202\nA 66 66 180.00 0 1 366 202\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 399 202\nL 399 202\nA 99 99 180.00 0 1 201 202\nL 201 202\nA 99 99 180.00 0 1 399 202\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 432 202\nL 432 202\nA 132 132 180.00 0 1 168 202\nL 168 202\nA 132 132 180.00 0 1 432 202\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 300 202\nL 300 70\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 300 202\nL 432 202\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 300 202\nL 300 334\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 300 202\nL 168 202\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><text x=\"398\" y=\"114\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"398\" y=\"300\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"187\" y=\"300\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">12</text><text x=\"187\" y=\"114\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">18</text><text x=\"280\" y=\"175\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">50</text><text x=\"273\" y=\"142\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">100</text><text x=\"273\" y=\"109\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">150</text><text x=\"273\" y=\"76\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">200</text><path  d=\"M 300 202\nL 318 126\nA 79 79 63.00 0 1 376 184\nL 300 202\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 300 202\nL 428 232\nA 132 132 63.00 0 1 330 330\nL 300 202\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 300 202\nL 277 298\nA 99 99 63.00 0 1 204 225\nL 300 202\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 300 202\nL 250 190\nA 52 52 63.00 0 1 288 152\nL 300 202\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/></svg>", string(data))
+
+	_, err = Render(ChartOption{
+		SeriesList: NewSeriesListDataFromValues([][]float64{
+			{
+				1,
+			},
+		}, ChartTypePie),
+	}, PolarOptionFunc(PolarOption{}))
+	assert.Equal("Polar only supports bar, line and scatter charts", err.Error())
+}
+
+func TestRadialBarRender(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := RadialBarRender(
+		[]float64{
+			64,
+			30,
+		},
+		SVGTypeOption(),
+		TitleTextOptionFunc("Usage"),
+		LegendLabelsOptionFunc([]string{
+			"Quota",
+			"Seats",
+		}),
+		RadialBarOptionFunc(RadialBarOption{
+			RoundCap: true,
+			Max:      80,
+		}),
+	)
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Usage</text><path  d=\"M 300 84\nA 118 118 180.00 0 1 300 320\nL 300 320\nA 118 118 180.00 0 1 300 84\nL 300 102\nL 297 102\nL 294 102\nL 290 103\nL 287 103\nL 283 104\nL 280 104\nL 276 105\nL 273 106\nL 270 107\nL 266 108\nL 263 110\nL 260 111\nL 257 112\nL 253 114\nL 250 116\nL 247 117\nL 244 119\nL 242 121\nL 239 123\nL 236 126\nL 233 128\nL 231 130\nL 228 133\nL 226 135\nL 224 138\nL 221 141\nL 219 144\nL 217 146\nL 215 149\nL 214 152\nL 212 155\nL 210 159\nL 209 162\nL 208 165\nL 206 168\nL 205 172\nL 204 175\nL 203 178\nL 202 182\nL 202 185\nL 201 189\nL 201 192\nL 200 196\nL 200 199\nL 200 202\nL 200 205\nL 200 208\nL 201 212\nL 201 215\nL 202 219\nL 202 222\nL 203 226\nL 204 229\nL 205 232\nL 206 236\nL 208 239\nL 209 242\nL 210 245\nL 212 249\nL 214 252\nL 215 255\nL 217 258\nL 219 260\nL 221 263\nL 224 266\nL 226 269\nL 228 271\nL 231 274\nL 233 276\nL 236 278\nL 239 281\nL 242 283\nL 244 285\nL 247 287\nL 250 288\nL 253 290\nL 257 292\nL 260 293\nL 263 294\nL 266 296\nL 270 297\nL 273 298\nL 276 299\nL 280 300\nL 283 300\nL 287 301\nL 290 301\nL 294 302\nL 297 302\nL 300 302\nL 303 302\nL 306 302\nL 310 301\nL 313 301\nL 317 300\nL 320 300\nL 324 299\nL 327 298\nL 330 297\nL 334 296\nL 337 294\nL 340 293\nL 343 292\nL 347 290\nL 350 288\nL 353 287\nL 356 285\nL 358 283\nL 361 281\nL 364 278\nL 367 276\nL 369 274\nL 372 271\nL 374 269\nL 376 266\nL 379 263\nL 381 260\nL 383 258\nL 385 255\nL 386 252\nL 388 249\nL 390 245\nL 391 242\nL 392 239\nL 394 236\nL 395 232\nL 396 229\nL 397 226\nL 398 222\nL 398 219\nL 399 215\nL 399 212\nL 400 208\nL 400 205\nL 400 202\nL 400 199\nL 400 196\nL 399 192\nL 399 189\nL 398 185\nL 398 182\nL 397 178\nL 396 175\nL 395 172\nL 394 168\nL 392 165\nL 391 162\nL 390 159\nL 388 155\nL 386 152\nL 385 149\nL 383 146\nL 381 144\nL 379 141\nL 376 138\nL 374 135\nL 372 133\nL 369 130\nL 367 128\nL 364 126\nL 361 123\nL 358 121\nL 356 119\nL 353 117\nL 350 116\nL 347 114\nL 343 112\nL 340 111\nL 337 110\nL 334 108\nL 330 107\nL 327 106\nL 324 105\nL 320 104\nL 317 104\nL 313 103\nL 310 103\nL 306 102\nL 303 102\nL 300 102\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 300 84\nA 118 118 288.00 1 1 188 166\nL 189 167\nA 8 8 180.00 0 1 205 171\nL 205 172\nL 204 175\nL 203 178\nL 202 182\nL 202 185\nL 201 189\nL 201 192\nL 200 196\nL 200 199\nL 200 202\nL 200 205\nL 200 208\nL 201 212\nL 201 215\nL 202 219\nL 202 222\nL 203 226\nL 204 229\nL 205 232\nL 206 236\nL 208 239\nL 209 242\nL 210 245\nL 212 249\nL 214 252\nL 215 255\nL 217 258\nL 219 260\nL 221 263\nL 224 266\nL 226 269\nL 228 271\nL 231 274\nL 233 276\nL 236 278\nL 239 281\nL 242 283\nL 244 285\nL 247 287\nL 250 288\nL 253 290\nL 257 292\nL 260 293\nL 263 294\nL 266 296\nL 270 297\nL 273 298\nL 276 299\nL 280 300\nL 283 300\nL 287 301\nL 290 301\nL 294 302\nL 297 302\nL 300 302\nL 303 302\nL 306 302\nL 310 301\nL 313 301\nL 317 300\nL 320 300\nL 324 299\nL 327 298\nL 330 297\nL 334 296\nL 337 294\nL 340 293\nL 343 292\nL 347 290\nL 350 288\nL 353 287\nL 356 285\nL 358 283\nL 361 281\nL 364 278\nL 367 276\nL 369 274\nL 372 271\nL 374 269\nL 376 266\nL 379 263\nL 381 260\nL 383 258\nL 385 255\nL 386 252\nL 388 249\nL 390 245\nL 391 242\nL 392 239\nL 394 236\nL 395 232\nL 396 229\nL 397 226\nL 398 222\nL 398 219\nL 399 215\nL 399 212\nL 400 208\nL 400 205\nL 400 202\nL 400 199\nL 400 196\nL 399 192\nL 399 189\nL 398 185\nL 398 182\nL 397 178\nL 396 175\nL 395 172\nL 394 168\nL 392 165\nL 391 162\nL 390 159\nL 388 155\nL 386 152\nL 385 149\nL 383 146\nL 381 144\nL 379 141\nL 376 138\nL 374 135\nL 372 133\nL 369 130\nL 367 128\nL 364 126\nL 361 123\nL 358 121\nL 356 119\nL 353 117\nL 350 116\nL 347 114\nL 343 112\nL 340 111\nL 337 110\nL 334 108\nL 330 107\nL 327 106\nL 324 105\nL 320 104\nL 317 104\nL 313 103\nL 310 103\nL 306 102\nL 303 102\nL 300 102\nL 300 101\nA 8 8 180.00 0 1 300 85\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><text x=\"225\" y=\"99\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Quota 80%</text><path  d=\"M 300 110\nA 92 92 180.00 0 1 300 294\nL 300 294\nA 92 92 180.00 0 1 300 110\nL 300 127\nL 298 128\nL 295 128\nL 293 128\nL 290 128\nL 287 129\nL 285 129\nL 282 130\nL 280 130\nL 277 131\nL 275 132\nL 272 133\nL 270 134\nL 268 135\nL 265 136\nL 263 138\nL 261 139\nL 259 140\nL 256 142\nL 254 143\nL 252 145\nL 250 147\nL 248 149\nL 247 150\nL 245 152\nL 243 154\nL 241 156\nL 240 158\nL 238 161\nL 237 163\nL 236 165\nL 234 167\nL 233 170\nL 232 172\nL 231 174\nL 230 177\nL 229 179\nL 228 182\nL 228 184\nL 227 187\nL 227 189\nL 226 192\nL 226 195\nL 226 197\nL 226 200\nL 225 202\nL 226 204\nL 226 207\nL 226 209\nL 226 212\nL 227 215\nL 227 217\nL 228 220\nL 228 222\nL 229 225\nL 230 227\nL 231 230\nL 232 232\nL 233 234\nL 234 237\nL 236 239\nL 237 241\nL 238 243\nL 240 246\nL 241 248\nL 243 250\nL 245 252\nL 247 254\nL 248 255\nL 250 257\nL 252 259\nL 254 261\nL 256 262\nL 259 264\nL 261 265\nL 263 266\nL 265 268\nL 268 269\nL 270 270\nL 272 271\nL 275 272\nL 277 273\nL 280 274\nL 282 274\nL 285 275\nL 287 275\nL 290 276\nL 293 276\nL 295 276\nL 298 276\nL 300 277\nL 302 276\nL 305 276\nL 307 276\nL 310 276\nL 313 275\nL 315 275\nL 318 274\nL 320 274\nL 323 273\nL 325 272\nL 328 271\nL 330 270\nL 332 269\nL 335 268\nL 337 266\nL 339 265\nL 341 264\nL 344 262\nL 346 261\nL 348 259\nL 350 257\nL 352 255\nL 353 254\nL 355 252\nL 357 250\nL 359 248\nL 360 246\nL 362 243\nL 363 241\nL 364 239\nL 366 237\nL 367 234\nL 368 232\nL 369 230\nL 370 227\nL 371 225\nL 372 222\nL 372 220\nL 373 217\nL 373 215\nL 374 212\nL 374 209\nL 374 207\nL 374 204\nL 375 202\nL 374 200\nL 374 197\nL 374 195\nL 374 192\nL 373 189\nL 373 187\nL 372 184\nL 372 182\nL 371 179\nL 370 177\nL 369 174\nL 368 172\nL 367 170\nL 366 167\nL 364 165\nL 363 163\nL 362 161\nL 360 158\nL 359 156\nL 357 154\nL 355 152\nL 353 150\nL 352 149\nL 350 147\nL 348 145\nL 346 143\nL 344 142\nL 341 140\nL 339 139\nL 337 138\nL 335 136\nL 332 135\nL 330 134\nL 328 133\nL 325 132\nL 323 131\nL 320 130\nL 318 130\nL 315 129\nL 313 129\nL 310 128\nL 307 128\nL 305 128\nL 302 128\nL 300 127\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 300 110\nA 92 92 135.00 0 1 365 267\nL 365 267\nA 8 8 180.00 0 1 353 255\nL 353 255\nL 354 253\nL 356 251\nL 358 249\nL 359 247\nL 361 245\nL 362 242\nL 364 240\nL 365 238\nL 366 236\nL 367 233\nL 368 231\nL 369 229\nL 370 226\nL 371 224\nL 372 221\nL 373 219\nL 373 216\nL 374 214\nL 374 211\nL 374 208\nL 374 206\nL 374 203\nL 375 202\nL 374 199\nL 374 196\nL 374 194\nL 374 191\nL 373 189\nL 373 186\nL 372 184\nL 371 181\nL 371 179\nL 370 176\nL 369 174\nL 368 171\nL 367 169\nL 365 167\nL 364 164\nL 363 162\nL 361 160\nL 360 158\nL 358 156\nL 357 154\nL 355 152\nL 353 150\nL 351 148\nL 349 146\nL 347 145\nL 345 143\nL 343 142\nL 341 140\nL 339 139\nL 337 137\nL 334 136\nL 332 135\nL 330 134\nL 327 133\nL 325 132\nL 323 131\nL 320 130\nL 318 130\nL 315 129\nL 312 129\nL 310 128\nL 307 128\nL 305 128\nL 302 128\nL 300 127\nL 300 127\nA 8 8 180.00 0 1 300 111\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><text x=\"216\" y=\"125\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Seats 37.5%</text></svg>", string(data))
+
+	_, err = Render(ChartOption{
+		SeriesList: append(NewSeriesListDataFromValues([][]float64{
+			{
+				1,
+			},
+		}), NewRadialBarSeriesList([]float64{
+			1,
+		}, nil)...),
+	})
+	assert.Equal("Radial bar can not mix other charts", err.Error())
+}
+
+func TestParallelRender(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := ParallelRender(
+		[][][]float64{
+			{
+				{
+					120,
+					45,
+					512,
+				},
+				{
+					135,
+					50,
+					498,
+				},
+			},
+			{
+				{
+					80,
+					70,
+					820,
+				},
+			},
+		},
+		SVGTypeOption(),
+		TitleTextOptionFunc("Benchmark"),
+		LegendLabelsOptionFunc([]string{
+			"v1",
+			"v2",
+		}, PositionRight),
+		ParallelAxisOptionFunc([]ParallelAxis{
+			{
+				Name: "Latency",
+			},
+			{
+				Name: "CPU",
+				Min:  0,
+				Max:  100,
+			},
+			{
+				Name: "Memory",
+			},
+		}),
+	)
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 464 29\nL 494 29\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><circle cx=\"479\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"496\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">v1</text><path  d=\"M 533 29\nL 563 29\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><circle cx=\"548\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"565\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">v2</text><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Benchmark</text><path  d=\"M 48 170\nL 302 227\nL 556 286\" style=\"stroke-width:1;stroke:rgba(84,112,198,0.7);fill:none\"/><path  d=\"M 48 105\nL 302 214\nL 556 293\" style=\"stroke-width:1;stroke:rgba(84,112,198,0.7);fill:none\"/><path  d=\"M 48 344\nL 302 162\nL 556 125\" style=\"stroke-width:1;stroke:rgba(145,204,117,0.7);fill:none\"/><path  d=\"M 48 83\nL 48 344\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 44 344\nL 48 344\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"27\" y=\"350\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text><path  d=\"M 44 301\nL 48 301\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"27\" y=\"307\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">90</text><path  d=\"M 44 257\nL 48 257\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"20\" y=\"263\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">100</text><path  d=\"M 44 214\nL 48 214\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"20\" y=\"220\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">110</text><path  d=\"M 44 170\nL 48 170\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"20\" y=\"176\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">120</text><path  d=\"M 44 127\nL 48 127\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"20\" y=\"133\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">130</text><path  d=\"M 44 83\nL 48 83\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"20\" y=\"89\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">140</text><text x=\"26\" y=\"67\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Latency</text><path  d=\"M 302 83\nL 302 344\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 298 344\nL 302 344\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"288\" y=\"350\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">0</text><path  d=\"M 298 292\nL 302 292\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"281\" y=\"298\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><path  d=\"M 298 240\nL 302 240\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"281\" y=\"246\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40</text><path  d=\"M 298 188\nL 302 188\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"281\" y=\"194\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">60</text><path  d=\"M 298 136\nL 302 136\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"281\" y=\"142\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text><path  d=\"M 298 83\nL 302 83\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"274\" y=\"89\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">100</text><text x=\"290\" y=\"67\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">CPU</text><path  d=\"M 556 83\nL 556 344\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 552 344\nL 556 344\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"528\" y=\"350\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">400</text><path  d=\"M 552 292\nL 556 292\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"528\" y=\"298\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">500</text><path  d=\"M 552 240\nL 556 240\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"528\" y=\"246\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">600</text><path  d=\"M 552 188\nL 556 188\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"528\" y=\"194\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">700</text><path  d=\"M 552 136\nL 556 136\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"528\" y=\"142\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">800</text><path  d=\"M 552 83\nL 556 83\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"528\" y=\"89\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">900</text><text x=\"532\" y=\"67\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Memory</text></svg>", string(data))
+
+	_, err = ParallelRender([][][]float64{
+		{
+			{
+				1,
+			},
+		},
+	})
+	assert.Equal("The count of parallel axis should be >= 2", err.Error())
+
+	_, err = Render(ChartOption{
+		SeriesList: append(NewSeriesListDataFromValues([][]float64{
+			{
+				1,
+			},
+		}), NewParallelSeriesList([][][]float64{
+			{
+				{
+					1,
+					2,
+				},
+			},
+		})...),
+	})
+	assert.Equal("Parallel can not mix other charts", err.Error())
+}
+
+func TestPieRenderZeroInnerRadius(t *testing.T) {
+	assert := assert.New(t)
+
+	render := func(radius ...string) string {
+		p, err := PieRender(
+			[]float64{
+				1048,
+				735,
+				580,
+			},
+			SVGTypeOption(),
+			PieSeriesRadius(radius...),
+		)
+		assert.Nil(err)
+		data, err := p.Bytes()
+		assert.Nil(err)
+		return string(data)
+	}
+	// 内半径为0时与饼图一致
+	assert.Equal(render("70%"), render("0%", "70%"))
+	assert.Equal(render("70%"), render("0", "70%"))
+	assert.NotEqual(render("70%"), render("20%", "70%"))
 }
 
 func TestHorizontalBarRender(t *testing.T) {
@@ -326,7 +1189,7 @@ func TestHorizontalBarRender(t *testing.T) {
 	assert.Nil(err)
 	data, err := p.Bytes()
 	assert.Nil(err)
-	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 214 29\nL 244 29\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><circle cx=\"229\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"246\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2011</text><path  d=\"M 301 29\nL 331 29\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><circle cx=\"316\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"333\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2012</text><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World Population</text><path  d=\"M 93 55\nL 98 55\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 93 104\nL 98 104\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 93 153\nL 98 153\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 93 202\nL 98 202\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 93 251\nL 98 251\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 93 300\nL 98 300\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 93 350\nL 98 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 98 55\nL 98 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"47\" y=\"86\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World</text><text x=\"48\" y=\"135\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">China</text><text x=\"54\" y=\"184\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">India</text><text x=\"58\" y=\"233\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">USA</text><text x=\"20\" y=\"282\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Indonesia</text><text x=\"49\" y=\"332\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Brazil</text><text x=\"94\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"147\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">122.28k</text><text x=\"224\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">244.56k</text><text x=\"301\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">366.84k</text><text x=\"378\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">489.12k</text><text x=\"459\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">611.4k</text><text x=\"532\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">733.68k</text><path  d=\"M 175 55\nL 175 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 252 55\nL 252 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 329 55\nL 329 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 406 55\nL 406 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 483 55\nL 483 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 560 55\nL 560 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 98 305\nL 109 305\nL 109 323\nL 98 323\nL 98 305\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 98 256\nL 112 256\nL 112 274\nL 98 274\nL 98 256\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 98 207\nL 116 207\nL 116 225\nL 98 225\nL 98 207\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 98 158\nL 164 158\nL 164 176\nL 98 176\nL 98 158\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 98 109\nL 180 109\nL 180 127\nL 98 127\nL 98 109\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 98 60\nL 494 60\nL 494 78\nL 98 78\nL 98 60\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 98 326\nL 110 326\nL 110 344\nL 98 344\nL 98 326\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 98 277\nL 112 277\nL 112 295\nL 98 295\nL 98 277\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 98 228\nL 117 228\nL 117 246\nL 98 246\nL 98 228\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 98 179\nL 174 179\nL 174 197\nL 98 197\nL 98 179\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 98 130\nL 182 130\nL 182 148\nL 98 148\nL 98 130\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 98 81\nL 527 81\nL 527 99\nL 98 99\nL 98 81\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/></svg>", string(data))
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 214 29\nL 244 29\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><circle cx=\"229\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"246\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2011</text><path  d=\"M 301 29\nL 331 29\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><circle cx=\"316\" cy=\"29\" r=\"5\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"333\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">2012</text><text x=\"20\" y=\"35\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World Population</text><path  d=\"M 93 55\nL 98 55\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 93 104\nL 98 104\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 93 153\nL 98 153\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 93 202\nL 98 202\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 93 251\nL 98 251\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 93 300\nL 98 300\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 93 350\nL 98 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 98 55\nL 98 350\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"47\" y=\"86\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">World</text><text x=\"48\" y=\"135\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">China</text><text x=\"54\" y=\"184\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">India</text><text x=\"58\" y=\"233\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">USA</text><text x=\"20\" y=\"282\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Indonesia</text><text x=\"49\" y=\"332\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Brazil</text><text x=\"94\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"147\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100k</text><text x=\"213\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">200k</text><text x=\"279\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">300k</text><text x=\"345\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">400k</text><text x=\"411\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">500k</text><text x=\"477\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">600k</text><text x=\"543\" y=\"375\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">700k</text><path  d=\"M 164 55\nL 164 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 230 55\nL 230 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 296 55\nL 296 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 362 55\nL 362 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 428 55\nL 428 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 494 55\nL 494 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 560 55\nL 560 350\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 98 305\nL 110 305\nL 110 323\nL 98 323\nL 98 305\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 98 256\nL 113 256\nL 113 274\nL 98 274\nL 98 256\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 98 207\nL 117 207\nL 117 225\nL 98 225\nL 98 207\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 98 158\nL 167 158\nL 167 176\nL 98 176\nL 98 158\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 98 109\nL 184 109\nL 184 127\nL 98 127\nL 98 109\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 98 60\nL 513 60\nL 513 78\nL 98 78\nL 98 60\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 98 326\nL 110 326\nL 110 344\nL 98 344\nL 98 326\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 98 277\nL 113 277\nL 113 295\nL 98 295\nL 98 277\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 98 228\nL 118 228\nL 118 246\nL 98 246\nL 98 228\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 98 179\nL 178 179\nL 178 197\nL 98 197\nL 98 179\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 98 130\nL 186 130\nL 186 148\nL 98 148\nL 98 130\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 98 81\nL 547 81\nL 547 99\nL 98 99\nL 98 81\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/></svg>", string(data))
 }
 
 func TestPieRender(t *testing.T) {
@@ -24,6 +24,7 @@ package charts
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"sort"
 
@@ -102,14 +103,22 @@ type defaultRenderOption struct {
 	TitleOption TitleOption
 	// The legend option
 	LegendOption LegendOption
+	// The visual map option
+	VisualMapOption *VisualMapOption
 	// background is filled
 	backgroundIsFilled bool
 	// x y axis is reversed
 	axisReversed bool
+	// x axis is value axis
+	xAxisIsValue bool
+	// y axis is category axis
+	yAxisIsCategory bool
 }
 
 type defaultRenderResult struct {
 	axisRanges map[int]axisRange
+	// The range of value x axis
+	xAxisRange axisRange
 	// 图例区域
 	seriesPainter *Painter
 }
@@ -160,6 +169,20 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 		}))
 	}
 
+	// 视觉映射组件展示在右侧
+	if opt.VisualMapOption != nil {
+		if opt.VisualMapOption.Theme == nil {
+			opt.VisualMapOption.Theme = opt.Theme
+		}
+		visualMapBox, err := NewVisualMapPainter(p, *opt.VisualMapOption).Render()
+		if err != nil {
+			return nil, err
+		}
+		p = p.Child(PainterPaddingOption(Box{
+			Right: visualMapBox.Width(),
+		}))
+	}
+
 	result := defaultRenderResult{
 		axisRanges: make(map[int]axisRange),
 	}
@@ -177,56 +200,127 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 	rangeWidthLeft := 0
 	rangeWidthRight := 0
 
-	// 倒序
-	sort.Sort(sort.Reverse(sort.IntSlice(axisIndexList)))
-
-	// 计算对应的axis range
+	// 计算对应的axis range，第一个轴优先计算，其它轴的刻度与其对齐
+	sort.Ints(axisIndexList)
+	yAxisOptions := make(map[int]YAxisOption)
 	for _, index := range axisIndexList {
 		yAxisOption := YAxisOption{}
 		if len(opt.YAxisOptions) > index {
 			yAxisOption = opt.YAxisOptions[index]
 		}
 		divideCount := yAxisOption.DivideCount
+		// 百分比堆叠
+		isPercent := opt.SeriesList.isStackPercent(index)
 		if divideCount <= 0 {
 			divideCount = defaultAxisDivideCount
+			if isPercent {
+				divideCount = defaultPercentAxisDivideCount
+			}
 		}
 		max, min := opt.SeriesList.GetMaxMin(index)
-		r := NewRange(AxisRangeOption{
-			Painter: p,
-			Min:     min,
-			Max:     max,
-			// 高度需要减去x轴的高度
-			Size: rangeHeight,
-			// 分隔数量
-			DivideCount: divideCount,
-		})
-		if yAxisOption.Min != nil && *yAxisOption.Min <= min {
-			r.min = *yAxisOption.Min
+		logBase := yAxisOption.getLogBase()
+		// 柱状图需要从0开始展示
+		if logBase == 0 && opt.SeriesList.hasBar(index) {
+			min = math.Min(min, 0)
+			max = math.Max(max, 0)
+		}
+		if logBase > 0 {
+			if min <= 0 {
+				return nil, fmt.Errorf("The value of log axis should be > 0, but got %v", min)
+			}
+			// 对数轴的刻度为幂值，因此指定的最小最大值参与范围计算
+			if yAxisOption.Min != nil && *yAxisOption.Min > 0 && *yAxisOption.Min <= min {
+				min = *yAxisOption.Min
+			}
+			if yAxisOption.Max != nil && *yAxisOption.Max >= max {
+				max = *yAxisOption.Max
+			}
 		}
-		if yAxisOption.Max != nil && *yAxisOption.Max >= max {
-			r.max = *yAxisOption.Max
+		var r axisRange
+		if opt.yAxisIsCategory || opt.axisReversed {
+			// 分类轴按分类数量等分
+			r = newCategoryRange(p, divideCount, rangeHeight)
+		} else {
+			rangeOption := AxisRangeOption{
+				Painter: p,
+				Min:     min,
+				Max:     max,
+				// 高度需要减去x轴的高度
+				Size: rangeHeight,
+				// 分隔数量
+				DivideCount: divideCount,
+				LogBase:     logBase,
+			}
+			if logBase == 0 && yAxisOption.Min != nil && *yAxisOption.Min <= min {
+				rangeOption.FixedMin = yAxisOption.Min
+				min = *yAxisOption.Min
+			}
+			if logBase == 0 && yAxisOption.Max != nil && *yAxisOption.Max >= max {
+				rangeOption.FixedMax = yAxisOption.Max
+				max = *yAxisOption.Max
+			}
+			r = NewRange(rangeOption)
+			if isPercent {
+				r.setPercent(min, divideCount)
+				if yAxisOption.Formatter == "" {
+					yAxisOption.Formatter = "{value}%"
+				}
+			}
+			// 多个y轴时刻度数量保持一致，使其与辅助线对齐
+			first, ok := result.axisRanges[axisIndexList[0]]
+			if ok && logBase == 0 && first.logBase == 0 && !isPercent {
+				r.alignDivideCount(first.divideCount, min, max, rangeOption.FixedMin != nil, rangeOption.FixedMax != nil)
+			}
 		}
 		result.axisRanges[index] = r
 
-		if yAxisOption.Theme == nil {
-			yAxisOption.Theme = opt.Theme
-		}
-		if !opt.axisReversed {
-			yAxisOption.Data = r.Values()
-		} else {
-			yAxisOption.isCategoryAxis = true
+		if opt.axisReversed {
 			// 由于x轴为value部分，因此计算其label单独处理
-			opt.XAxis.Data = NewRange(AxisRangeOption{
+			xDivideCount := defaultAxisDivideCount
+			if isPercent {
+				xDivideCount = defaultPercentAxisDivideCount
+			}
+			xRange := NewRange(AxisRangeOption{
 				Painter: p,
 				Min:     min,
 				Max:     max,
 				// 高度需要减去x轴的高度
 				Size: rangeHeight,
 				// 分隔数量
-				DivideCount: defaultAxisDivideCount,
-			}).Values()
+				DivideCount: xDivideCount,
+			})
+			if isPercent {
+				xRange.setPercent(min, xDivideCount)
+				if opt.XAxis.Formatter == "" {
+					opt.XAxis.Formatter = "{value}%"
+				}
+			}
+			result.xAxisRange = xRange
+			opt.XAxis.Data = xRange.Values()
 			opt.XAxis.isValueAxis = true
 		}
+		yAxisOptions[index] = yAxisOption
+	}
+
+	// 倒序
+	sort.Sort(sort.Reverse(sort.IntSlice(axisIndexList)))
+
+	for _, index := range axisIndexList {
+		yAxisOption := yAxisOptions[index]
+		r := result.axisRanges[index]
+
+		if yAxisOption.Theme == nil {
+			yAxisOption.Theme = opt.Theme
+		}
+		if opt.yAxisIsCategory {
+			yAxisOption.isCategoryAxis = true
+			// 复制数据，避免反转时修改原数据
+			yAxisOption.Data = append([]string{}, yAxisOption.Data...)
+		} else if !opt.axisReversed {
+			yAxisOption.Data = r.Values()
+		} else {
+			yAxisOption.isCategoryAxis = true
+		}
 		reverseStringSlice(yAxisOption.Data)
 		// TODO生成其它位置既yAxis
 		var yAxis *axisPainter
@@ -250,6 +344,44 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 		}
 	}
 
+	// 横向柱状图的x轴宽度为去除y轴后的宽度
+	if opt.axisReversed {
+		result.xAxisRange.size = p.Width() - rangeWidthLeft - rangeWidthRight
+	}
+
+	// x轴为时间轴
+	if opt.XAxis.Type == AxisTypeTime {
+		r, ticks := newTimeAxisRange(p, opt.XAxis, opt.SeriesList, p.Width()-rangeWidthLeft-rangeWidthRight)
+		data := make([]string, len(ticks))
+		positions := make([]int, len(ticks))
+		for index, tick := range ticks {
+			data[index] = tick.label
+			positions[index] = r.getHeight(timeToValue(tick.value))
+		}
+		result.xAxisRange = r
+		opt.XAxis.Data = data
+		opt.XAxis.positions = positions
+	} else if opt.xAxisIsValue {
+		max, min := opt.SeriesList.GetXMaxMin()
+		rangeOption := AxisRangeOption{
+			Painter:     p,
+			Min:         min,
+			Max:         max,
+			Size:        p.Width() - rangeWidthLeft - rangeWidthRight,
+			DivideCount: opt.XAxis.SplitNumber,
+		}
+		if opt.XAxis.Min != nil && *opt.XAxis.Min <= min {
+			rangeOption.FixedMin = opt.XAxis.Min
+		}
+		if opt.XAxis.Max != nil && *opt.XAxis.Max >= max {
+			rangeOption.FixedMax = opt.XAxis.Max
+		}
+		r := NewRange(rangeOption)
+		result.xAxisRange = r
+		opt.XAxis.Data = r.Values()
+		opt.XAxis.isValueAxis = true
+	}
+
 	if opt.XAxis.Theme == nil {
 		opt.XAxis.Theme = opt.Theme
 	}
@@ -322,6 +454,17 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 	pieSeriesList := seriesList.Filter(ChartTypePie)
 	radarSeriesList := seriesList.Filter(ChartTypeRadar)
 	funnelSeriesList := seriesList.Filter(ChartTypeFunnel)
+	scatterSeriesList := seriesList.Filter(ChartTypeScatter)
+	heatmapSeriesList := seriesList.Filter(ChartTypeHeatmap)
+	candlestickSeriesList := seriesList.Filter(ChartTypeCandlestick)
+	gaugeSeriesList := seriesList.Filter(ChartTypeGauge)
+	boxPlotSeriesList := seriesList.Filter(ChartTypeBoxPlot)
+	waterfallSeriesList := seriesList.Filter(ChartTypeWaterfall)
+	ganttSeriesList := seriesList.Filter(ChartTypeGantt)
+	calendarSeriesList := seriesList.Filter(ChartTypeCalendar)
+	bulletSeriesList := seriesList.Filter(ChartTypeBullet)
+	radialBarSeriesList := seriesList.Filter(ChartTypeRadialBar)
+	parallelSeriesList := seriesList.Filter(ChartTypeParallel)
 
 	if len(horizontalBarSeriesList) != 0 && len(horizontalBarSeriesList) != seriesCount {
 		return nil, errors.New("Horizontal bar can not mix other charts")
@@ -335,6 +478,44 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 	if len(funnelSeriesList) != 0 && len(funnelSeriesList) != seriesCount {
 		return nil, errors.New("Funnel can not mix other charts")
 	}
+	if len(scatterSeriesList) != 0 && len(scatterSeriesList) != seriesCount {
+		return nil, errors.New("Scatter can not mix other charts")
+	}
+	if len(heatmapSeriesList) != 0 && len(heatmapSeriesList) != seriesCount {
+		return nil, errors.New("Heatmap can not mix other charts")
+	}
+	if len(gaugeSeriesList) != 0 && len(gaugeSeriesList) != seriesCount {
+		return nil, errors.New("Gauge can not mix other charts")
+	}
+	if len(ganttSeriesList) != 0 && len(ganttSeriesList) != seriesCount {
+		return nil, errors.New("Gantt can not mix other charts")
+	}
+	if len(calendarSeriesList) != 0 && len(calendarSeriesList) != seriesCount {
+		return nil, errors.New("Calendar can not mix other charts")
+	}
+	if len(bulletSeriesList) != 0 && len(bulletSeriesList) != seriesCount {
+		return nil, errors.New("Bullet can not mix other charts")
+	}
+	if len(radialBarSeriesList) != 0 && len(radialBarSeriesList) != seriesCount {
+		return nil, errors.New("Radial bar can not mix other charts")
+	}
+	if len(parallelSeriesList) != 0 && len(parallelSeriesList) != seriesCount {
+		return nil, errors.New("Parallel can not mix other charts")
+	}
+	if opt.Polar != nil &&
+		len(barSeriesList)+len(lineSeriesList)+len(scatterSeriesList) != seriesCount {
+		return nil, errors.New("Polar only supports bar, line and scatter charts")
+	}
+	if len(opt.TreemapNodes) != 0 && seriesCount != 0 {
+		return nil, errors.New("Treemap can not mix other charts")
+	}
+	if len(opt.SunburstNodes) != 0 && (seriesCount != 0 || len(opt.TreemapNodes) != 0) {
+		return nil, errors.New("Sunburst can not mix other charts")
+	}
+	if len(opt.SankeyNodes) != 0 &&
+		(seriesCount != 0 || len(opt.TreemapNodes) != 0 || len(opt.SunburstNodes) != 0) {
+		return nil, errors.New("Sankey can not mix other charts")
+	}
 
 	axisReversed := len(horizontalBarSeriesList) != 0
 	renderOpt := defaultRenderOption{
@@ -346,12 +527,21 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 		TitleOption:  opt.Title,
 		LegendOption: opt.Legend,
 		axisReversed: axisReversed,
+		xAxisIsValue: len(scatterSeriesList) != 0,
 		// 前置已设置背景色
 		backgroundIsFilled: true,
 	}
 	if len(pieSeriesList) != 0 ||
 		len(radarSeriesList) != 0 ||
-		len(funnelSeriesList) != 0 {
+		len(funnelSeriesList) != 0 ||
+		len(gaugeSeriesList) != 0 ||
+		len(calendarSeriesList) != 0 ||
+		len(radialBarSeriesList) != 0 ||
+		len(parallelSeriesList) != 0 ||
+		len(opt.TreemapNodes) != 0 ||
+		len(opt.SunburstNodes) != 0 ||
+		len(opt.SankeyNodes) != 0 ||
+		opt.Polar != nil {
 		renderOpt.XAxis.Show = FalseFlag()
 		renderOpt.YAxisOptions = []YAxisOption{
 			{
@@ -363,6 +553,47 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 		renderOpt.YAxisOptions[0].DivideCount = len(renderOpt.YAxisOptions[0].Data)
 		renderOpt.YAxisOptions[0].Unit = 1
 	}
+	if len(gaugeSeriesList) != 0 ||
+		len(calendarSeriesList) != 0 ||
+		len(radialBarSeriesList) != 0 ||
+		len(opt.TreemapNodes) != 0 ||
+		len(opt.SunburstNodes) != 0 ||
+		len(opt.SankeyNodes) != 0 {
+		// 仪表盘、日历图、径向柱状图、矩形树图、旭日图与桑基图不展示图例
+		renderOpt.LegendOption.Show = FalseFlag()
+	}
+	if len(heatmapSeriesList) != 0 {
+		opt.VisualMap.fillDefault(heatmapSeriesList)
+		renderOpt.VisualMapOption = &opt.VisualMap
+		renderOpt.yAxisIsCategory = true
+		renderOpt.YAxisOptions[0] = heatmapYAxisOption(renderOpt.YAxisOptions[0], heatmapSeriesList)
+		renderOpt.XAxis.BoundaryGap = TrueFlag()
+		// 热力图使用visual map，不展示图例
+		renderOpt.LegendOption.Show = FalseFlag()
+	}
+	if len(parallelSeriesList) != 0 {
+		renderOpt.VisualMapOption = parallelVisualMap(opt.VisualMap, parallelSeriesList)
+		// 按维度着色时，使用visual map代替图例
+		if renderOpt.VisualMapOption != nil {
+			renderOpt.LegendOption.Show = FalseFlag()
+		}
+	}
+
+	if len(bulletSeriesList) != 0 {
+		renderOpt = bulletRenderOption(renderOpt, opt.Bullet)
+	}
+	if len(ganttSeriesList) != 0 {
+		// 甘特图的y轴为任务名称，x轴为时间轴
+		renderOpt.yAxisIsCategory = true
+		renderOpt.XAxis.Type = AxisTypeTime
+		yAxisOptions := append([]YAxisOption{}, renderOpt.YAxisOptions...)
+		yAxisOptions[0] = ganttYAxisOption(yAxisOptions[0])
+		renderOpt.YAxisOptions = yAxisOptions
+		// 未分组时不展示图例
+		if len(ganttSeriesList) == 1 && ganttSeriesList[0].Name == "" {
+			renderOpt.LegendOption.Show = FalseFlag()
+		}
+	}
 
 	renderResult, err := defaultRender(p, renderOpt)
 	if err != nil {
@@ -371,15 +602,108 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 
 	handler := renderHandler{}
 
+	// 极坐标系的柱状图、折线图与散点图统一绘制
+	if opt.Polar != nil {
+		polarSeriesList := seriesList
+		barSeriesList = nil
+		lineSeriesList = nil
+		scatterSeriesList = nil
+		handler.Add(func() error {
+			_, err := NewPolarChart(p, PolarChartOption{
+				Theme:        opt.theme,
+				Font:         opt.font,
+				XAxis:        opt.XAxis,
+				YAxisOptions: opt.YAxisOptions,
+				Polar:        *opt.Polar,
+				SymbolShow:   opt.SymbolShow,
+				StrokeWidth:  opt.LineStrokeWidth,
+				FillArea:     opt.FillArea,
+				Opacity:      opt.Opacity,
+				SymbolSize:   opt.SymbolSize,
+			}).render(renderResult, polarSeriesList)
+			return err
+		})
+	}
+
 	// bar chart
 	if len(barSeriesList) != 0 {
 		handler.Add(func() error {
 			_, err := NewBarChart(p, BarChartOption{
+				Theme:     opt.theme,
+				Font:      opt.font,
+				XAxis:     opt.XAxis,
+				BarWidth:  opt.BarWidth,
+				BarMargin: opt.BarMargin,
+			}).render(renderResult, barSeriesList)
+			return err
+		})
+	}
+
+	// candlestick chart
+	if len(candlestickSeriesList) != 0 {
+		handler.Add(func() error {
+			_, err := NewCandlestickChart(p, CandlestickChartOption{
 				Theme:    opt.theme,
 				Font:     opt.font,
 				XAxis:    opt.XAxis,
 				BarWidth: opt.BarWidth,
-			}).render(renderResult, barSeriesList)
+			}).render(renderResult, candlestickSeriesList)
+			return err
+		})
+	}
+
+	// box plot chart
+	if len(boxPlotSeriesList) != 0 {
+		handler.Add(func() error {
+			_, err := NewBoxPlotChart(p, BoxPlotChartOption{
+				Theme:    opt.theme,
+				Font:     opt.font,
+				XAxis:    opt.XAxis,
+				BarWidth: opt.BarWidth,
+			}).render(renderResult, boxPlotSeriesList)
+			return err
+		})
+	}
+
+	// waterfall chart
+	if len(waterfallSeriesList) != 0 {
+		handler.Add(func() error {
+			_, err := NewWaterfallChart(p, WaterfallChartOption{
+				Theme:     opt.theme,
+				Font:      opt.font,
+				XAxis:     opt.XAxis,
+				BarWidth:  opt.BarWidth,
+				Waterfall: opt.Waterfall,
+			}).render(renderResult, waterfallSeriesList)
+			return err
+		})
+	}
+
+	// bullet chart
+	if len(bulletSeriesList) != 0 {
+		handler.Add(func() error {
+			_, err := NewBulletChart(p, BulletChartOption{
+				Theme:     opt.theme,
+				Font:      opt.font,
+				XAxis:     opt.XAxis,
+				Bullet:    opt.Bullet,
+				BarWidth:  opt.BarWidth,
+				BarHeight: opt.BarHeight,
+			}).render(renderResult, bulletSeriesList)
+			return err
+		})
+	}
+
+	// gantt chart
+	if len(ganttSeriesList) != 0 {
+		handler.Add(func() error {
+			_, err := NewGanttChart(p, GanttChartOption{
+				Theme:        opt.theme,
+				Font:         opt.font,
+				XAxis:        opt.XAxis,
+				YAxisOptions: opt.YAxisOptions,
+				BarHeight:    opt.BarHeight,
+			}).render(renderResult, ganttSeriesList)
 			return err
 		})
 	}
@@ -437,6 +761,21 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 		})
 	}
 
+	// parallel chart
+	if len(parallelSeriesList) != 0 {
+		handler.Add(func() error {
+			_, err := NewParallelChart(p, ParallelChartOption{
+				Theme:        opt.theme,
+				Font:         opt.font,
+				ParallelAxes: opt.ParallelAxes,
+				VisualMap:    opt.VisualMap,
+				StrokeWidth:  opt.LineStrokeWidth,
+				Opacity:      opt.Opacity,
+			}).render(renderResult, parallelSeriesList)
+			return err
+		})
+	}
+
 	// funnel chart
 	if len(funnelSeriesList) != 0 {
 		handler.Add(func() error {
@@ -448,6 +787,105 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 		})
 	}
 
+	// scatter chart
+	if len(scatterSeriesList) != 0 {
+		handler.Add(func() error {
+			_, err := NewScatterChart(p, ScatterChartOption{
+				Theme:      opt.theme,
+				Font:       opt.font,
+				XAxis:      opt.XAxis,
+				SymbolSize: opt.SymbolSize,
+			}).render(renderResult, scatterSeriesList)
+			return err
+		})
+	}
+
+	// heatmap chart
+	if len(heatmapSeriesList) != 0 {
+		handler.Add(func() error {
+			_, err := NewHeatmapChart(p, HeatmapChartOption{
+				Theme:        opt.theme,
+				Font:         opt.font,
+				XAxis:        opt.XAxis,
+				YAxisOptions: renderOpt.YAxisOptions,
+				VisualMap:    opt.VisualMap,
+			}).render(renderResult, heatmapSeriesList)
+			return err
+		})
+	}
+
+	// calendar chart
+	if len(calendarSeriesList) != 0 {
+		handler.Add(func() error {
+			_, err := NewCalendarChart(p, CalendarChartOption{
+				Theme:    opt.theme,
+				Font:     opt.font,
+				Calendar: opt.Calendar,
+			}).render(renderResult, calendarSeriesList)
+			return err
+		})
+	}
+
+	// gauge chart
+	if len(gaugeSeriesList) != 0 {
+		handler.Add(func() error {
+			_, err := NewGaugeChart(p, GaugeChartOption{
+				Theme: opt.theme,
+				Font:  opt.font,
+				Gauge: opt.Gauge,
+			}).render(renderResult, gaugeSeriesList)
+			return err
+		})
+	}
+
+	// radial bar chart
+	if len(radialBarSeriesList) != 0 {
+		handler.Add(func() error {
+			_, err := NewRadialBarChart(p, RadialBarChartOption{
+				Theme:     opt.theme,
+				Font:      opt.font,
+				RadialBar: opt.RadialBar,
+				BarWidth:  opt.BarWidth,
+			}).render(renderResult, radialBarSeriesList)
+			return err
+		})
+	}
+
+	// treemap chart
+	if len(opt.TreemapNodes) != 0 {
+		handler.Add(func() error {
+			_, err := NewTreemapChart(p, TreemapChartOption{
+				Theme: opt.theme,
+				Font:  opt.font,
+			}).render(renderResult, opt.TreemapNodes)
+			return err
+		})
+	}
+
+	// sunburst chart
+	if len(opt.SunburstNodes) != 0 {
+		handler.Add(func() error {
+			_, err := NewSunburstChart(p, SunburstChartOption{
+				Theme:    opt.theme,
+				Font:     opt.font,
+				Sunburst: opt.Sunburst,
+			}).render(renderResult, opt.SunburstNodes)
+			return err
+		})
+	}
+
+	// sankey chart
+	if len(opt.SankeyNodes) != 0 {
+		handler.Add(func() error {
+			_, err := NewSankeyChart(p, SankeyChartOption{
+				Theme:  opt.theme,
+				Font:   opt.font,
+				Sankey: opt.Sankey,
+			}).render(renderResult, opt.SankeyNodes, opt.SankeyLinks)
+			return err
+		})
+	}
+
 	err = handler.Do()
 
 	if err != nil {
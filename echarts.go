@@ -28,6 +28,8 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/wcharczuk/go-chart/v2"
 )
@@ -56,6 +58,64 @@ func (p *EChartsPosition) UnmarshalJSON(data []byte) error {
 	return json.Unmarshal(data, s)
 }
 
+// EChartsRadius is the radius of pie, it can be a value or
+// an inner and outer radius pair, e.g.: ["40%", "70%"]
+type EChartsRadius []EChartsPosition
+
+func (er *EChartsRadius) UnmarshalJSON(data []byte) error {
+	data = convertToArray(data)
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, (*[]EChartsPosition)(er))
+}
+
+// Inner returns the inner radius
+func (er EChartsRadius) Inner() string {
+	if len(er) < 2 {
+		return ""
+	}
+	return string(er[0])
+}
+
+// Outer returns the outer radius
+func (er EChartsRadius) Outer() string {
+	if len(er) == 0 {
+		return ""
+	}
+	return string(er[len(er)-1])
+}
+
+// EChartsRoseType is the rose type of pie, true is the same as "radius"
+type EChartsRoseType string
+
+func (et *EChartsRoseType) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch string(data) {
+	case "true":
+		*et = PieRoseTypeRadius
+		return nil
+	case "false", "null":
+		return nil
+	}
+	return json.Unmarshal(data, (*string)(et))
+}
+
+// EChartsStep is the step of line, true is the same as "start"
+type EChartsStep string
+
+func (es *EChartsStep) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch string(data) {
+	case "true":
+		*es = StepStart
+		return nil
+	case "false", "null":
+		return nil
+	}
+	return json.Unmarshal(data, (*string)(es))
+}
+
 type EChartStyle struct {
 	Color string `json:"color"`
 }
@@ -115,6 +175,10 @@ func (es *EChartsSeriesData) UnmarshalJSON(data []byte) error {
 		}
 		return nil
 	}
+	// 多维数据，如[x, y]
+	if data[0] == '[' {
+		return es.Value.UnmarshalJSON(data)
+	}
 	v := _EChartsSeriesData{}
 	err := json.Unmarshal(data, &v)
 	if err != nil {
@@ -131,6 +195,8 @@ type EChartsXAxisData struct {
 	SplitNumber int      `json:"splitNumber"`
 	Data        []string `json:"data"`
 	Type        string   `json:"type"`
+	Min         *float64 `json:"min"`
+	Max         *float64 `json:"max"`
 }
 type EChartsXAxis struct {
 	Data []EChartsXAxisData
@@ -156,7 +222,9 @@ type EChartsYAxisData struct {
 			Color string `json:"color"`
 		} `json:"lineStyle"`
 	} `json:"axisLine"`
-	Data []string `json:"data"`
+	Data    []string `json:"data"`
+	Type    string   `json:"type"`
+	LogBase float64  `json:"logBase"`
 }
 type EChartsYAxis struct {
 	Data []EChartsYAxisData `json:"data"`
@@ -170,6 +238,53 @@ func (ey *EChartsYAxis) UnmarshalJSON(data []byte) error {
 	return json.Unmarshal(data, &ey.Data)
 }
 
+type EChartsVisualMapData struct {
+	Show       *bool    `json:"show"`
+	Min        *float64 `json:"min"`
+	Max        *float64 `json:"max"`
+	ItemWidth  int      `json:"itemWidth"`
+	ItemHeight int      `json:"itemHeight"`
+	InRange    struct {
+		Color []string `json:"color"`
+	} `json:"inRange"`
+	TextStyle EChartsTextStyle `json:"textStyle"`
+}
+type EChartsVisualMap struct {
+	Data []EChartsVisualMapData
+}
+
+func (ev *EChartsVisualMap) UnmarshalJSON(data []byte) error {
+	data = convertToArray(data)
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, &ev.Data)
+}
+
+func (ev *EChartsVisualMap) ToVisualMapOption() VisualMapOption {
+	if len(ev.Data) == 0 {
+		return VisualMapOption{}
+	}
+	item := ev.Data[0]
+	textStyle := item.TextStyle.ToStyle()
+	opt := VisualMapOption{
+		Show:       item.Show,
+		Min:        item.Min,
+		Max:        item.Max,
+		ItemWidth:  item.ItemWidth,
+		ItemHeight: item.ItemHeight,
+		FontSize:   textStyle.FontSize,
+		FontColor:  textStyle.FontColor,
+	}
+	if len(item.InRange.Color) != 0 {
+		opt.Colors = make([]Color, len(item.InRange.Color))
+		for index, color := range item.InRange.Color {
+			opt.Colors[index] = parseColor(color)
+		}
+	}
+	return opt
+}
+
 type EChartsPadding struct {
 	Box chart.Box
 }
@@ -296,11 +411,46 @@ func (eml *EChartsMarkLine) ToSeriesMarkLine() SeriesMarkLine {
 	return sl
 }
 
+type EChartsGaugeBand struct {
+	Percent float64
+	Color   string
+}
+
+// UnmarshalJSON parses the band of gauge, e.g.: [0.3, "#67e0e3"]
+func (eb *EChartsGaugeBand) UnmarshalJSON(data []byte) error {
+	values := make([]interface{}, 0)
+	err := json.Unmarshal(data, &values)
+	if err != nil {
+		return err
+	}
+	if len(values) != 2 {
+		return nil
+	}
+	if v, ok := values[0].(float64); ok {
+		eb.Percent = v
+	}
+	if v, ok := values[1].(string); ok {
+		eb.Color = v
+	}
+	return nil
+}
+
+type EChartsGaugeAxisLine struct {
+	LineStyle struct {
+		Color []EChartsGaugeBand `json:"color"`
+	} `json:"lineStyle"`
+}
+
+type EChartsGaugeDetail struct {
+	Formatter string `json:"formatter"`
+}
+
 type EChartsSeries struct {
 	Data       []EChartsSeriesData `json:"data"`
 	Name       string              `json:"name"`
 	Type       string              `json:"type"`
 	Radius     string              `json:"radius"`
+	RoseType   EChartsRoseType     `json:"roseType"`
 	YAxisIndex int                 `json:"yAxisIndex"`
 	ItemStyle  EChartStyle         `json:"itemStyle"`
 	// label的配置
@@ -309,12 +459,114 @@ type EChartsSeries struct {
 	MarkLine  EChartsMarkLine    `json:"markLine"`
 	Max       *float64           `json:"max"`
 	Min       *float64           `json:"min"`
+	// The inner radius of pie, it's set if the radius of json is [inner, outer]
+	InnerRadius string `json:"-"`
+	// The symbol size of scatter chart
+	SymbolSize float64 `json:"symbolSize"`
+	// The stack group of series
+	Stack string `json:"stack"`
+	// The step of line series
+	Step EChartsStep `json:"step"`
+	// The start angle of gauge
+	StartAngle *float64 `json:"startAngle"`
+	// The end angle of gauge
+	EndAngle *float64 `json:"endAngle"`
+	// The split number of gauge
+	SplitNumber int                  `json:"splitNumber"`
+	AxisLine    EChartsGaugeAxisLine `json:"axisLine"`
+	Detail      EChartsGaugeDetail   `json:"detail"`
+	// The links of sankey, the source and target are the names of node
+	Links []EChartsSankeyLink `json:"links"`
+	// The node width of sankey
+	NodeWidth int `json:"nodeWidth"`
+	// The node gap of sankey
+	NodeGap int `json:"nodeGap"`
+}
+
+type EChartsSankeyLink struct {
+	Source string  `json:"source"`
+	Target string  `json:"target"`
+	Value  float64 `json:"value"`
+}
+
+// UnmarshalJSON parses the series, the radius can be a string or [inner, outer],
+// the outer radius is set to Radius and the inner radius is set to InnerRadius
+func (es *EChartsSeries) UnmarshalJSON(data []byte) error {
+	type echartsSeries EChartsSeries
+	v := struct {
+		*echartsSeries
+		Radius EChartsRadius `json:"radius"`
+	}{
+		echartsSeries: (*echartsSeries)(es),
+	}
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+	es.Radius = v.Radius.Outer()
+	es.InnerRadius = v.Radius.Inner()
+	return nil
+}
+
+// ToSankeyOption converts the sankey series to nodes, links and option
+func (es *EChartsSeries) ToSankeyOption() ([]SankeyNode, []SankeyLink, SankeyOption) {
+	nodes := make([]SankeyNode, len(es.Data))
+	for index, item := range es.Data {
+		nodes[index] = SankeyNode{
+			Name:  item.Name,
+			Color: parseColor(item.ItemStyle.Color),
+		}
+	}
+	links := make([]SankeyLink, len(es.Links))
+	for index, item := range es.Links {
+		links[index] = SankeyLink{
+			Source: item.Source,
+			Target: item.Target,
+			Value:  item.Value,
+		}
+	}
+	return nodes, links, SankeyOption{
+		NodeWidth: es.NodeWidth,
+		NodeGap:   es.NodeGap,
+	}
 }
+
+// ToGaugeOption converts the gauge series to gauge option
+func (es *EChartsSeries) ToGaugeOption() GaugeOption {
+	opt := GaugeOption{
+		StartAngle:  225,
+		EndAngle:    -45,
+		SplitNumber: es.SplitNumber,
+	}
+	if es.StartAngle != nil {
+		opt.StartAngle = *es.StartAngle
+	}
+	if es.EndAngle != nil {
+		opt.EndAngle = *es.EndAngle
+	}
+	for _, item := range es.AxisLine.LineStyle.Color {
+		opt.Bands = append(opt.Bands, GaugeBand{
+			Percent: item.Percent,
+			Color:   parseColor(item.Color),
+		})
+	}
+	return opt
+}
+
 type EChartsSeriesList []EChartsSeries
 
 func (esList EChartsSeriesList) ToSeriesList() SeriesList {
+	return esList.toSeriesList(false)
+}
+
+// toSeriesList converts the series list, the data of line and scatter is [timestamp, value] if x axis is time axis
+func (esList EChartsSeriesList) toSeriesList(isTimeAxis bool) SeriesList {
 	seriesList := make(SeriesList, 0, len(esList))
 	for _, item := range esList {
+		// sankey的节点与连线不转换为series
+		if item.Type == ChartTypeSankey {
+			continue
+		}
 		// 如果是pie，则每个子荐生成一个series
 		if item.Type == ChartTypePie {
 			for _, dataItem := range item.Data {
@@ -324,7 +576,9 @@ func (esList EChartsSeriesList) ToSeriesList() SeriesList {
 					Label: SeriesLabel{
 						Show: true,
 					},
-					Radius: item.Radius,
+					Radius:      item.Radius,
+					InnerRadius: item.InnerRadius,
+					RoseType:    string(item.RoseType),
 					Data: []SeriesData{
 						{
 							Value: dataItem.Value.First(),
@@ -353,12 +607,90 @@ func (esList EChartsSeriesList) ToSeriesList() SeriesList {
 			}
 			continue
 		}
+		// gauge的每个数据为一个指针
+		if item.Type == ChartTypeGauge {
+			for _, dataItem := range item.Data {
+				seriesList = append(seriesList, Series{
+					Name:   dataItem.Name,
+					Type:   item.Type,
+					Radius: item.Radius,
+					Data: []SeriesData{
+						{
+							Value: dataItem.Value.First(),
+						},
+					},
+					Max: item.Max,
+					Min: item.Min,
+					Label: SeriesLabel{
+						Color:     parseColor(item.Label.Color),
+						Formatter: strings.ReplaceAll(item.Detail.Formatter, "{value}", "{c}"),
+					},
+				})
+			}
+			continue
+		}
+		// heatmap的数据为[x, y, value]，每个y轴分类生成一个series
+		if item.Type == ChartTypeHeatmap {
+			rows := make([][]float64, 0)
+			for _, dataItem := range item.Data {
+				values := dataItem.Value.values
+				if len(values) < 3 || values[0] < 0 || values[1] < 0 {
+					continue
+				}
+				x := int(values[0])
+				y := int(values[1])
+				for len(rows) <= y {
+					rows = append(rows, make([]float64, 0))
+				}
+				for len(rows[y]) <= x {
+					rows[y] = append(rows[y], nullValue)
+				}
+				rows[y][x] = values[2]
+			}
+			for _, row := range rows {
+				seriesList = append(seriesList, Series{
+					Type: item.Type,
+					Data: NewSeriesDataFromValues(row),
+					Label: SeriesLabel{
+						Color:    parseColor(item.Label.Color),
+						Show:     item.Label.Show,
+						Distance: item.Label.Distance,
+					},
+				})
+			}
+			continue
+		}
 		data := make([]SeriesData, len(item.Data))
 		for j, dataItem := range item.Data {
 			data[j] = SeriesData{
 				Value: dataItem.Value.First(),
 				Style: dataItem.ItemStyle.ToStyle(),
 			}
+			// scatter的数据为[x, y, size]
+			if item.Type == ChartTypeScatter {
+				data[j] = newScatterSeriesData(dataItem.Value.values)
+				data[j].Style = dataItem.ItemStyle.ToStyle()
+			}
+			// 时间轴的line与scatter数据为[timestamp, value]，时间戳为毫秒
+			isTimeData := item.Type == ChartTypeLine || item.Type == ChartTypeScatter
+			if isTimeAxis && isTimeData && len(dataItem.Value.values) >= 2 {
+				values := dataItem.Value.values
+				data[j].Time = time.Unix(0, int64(values[0])*int64(time.Millisecond))
+				data[j].Value = values[1]
+			}
+			// candlestick的数据为[open, close, lowest, highest]
+			if item.Type == ChartTypeCandlestick {
+				data[j] = newCandlestickSeriesData(dataItem.Value.values)
+				data[j].Style = dataItem.ItemStyle.ToStyle()
+			}
+			// boxplot的数据为[lowest, Q1, median, Q3, highest]
+			if item.Type == ChartTypeBoxPlot {
+				data[j] = newBoxPlotSeriesData(dataItem.Value.values)
+				// 箱体使用半透明填充，因此颜色仅用于描边
+				data[j].Style = Style{
+					StrokeColor: parseColor(dataItem.ItemStyle.Color),
+				}
+			}
 		}
 		seriesList = append(seriesList, Series{
 			Type:      item.Type,
@@ -373,6 +705,8 @@ func (esList EChartsSeriesList) ToSeriesList() SeriesList {
 			Name:      item.Name,
 			MarkPoint: item.MarkPoint.ToSeriesMarkPoint(),
 			MarkLine:  item.MarkLine.ToSeriesMarkLine(),
+			Stack:     item.Stack,
+			Step:      string(item.Step),
 		})
 	}
 	return seriesList
@@ -417,8 +751,9 @@ type EChartsOption struct {
 	Radar  struct {
 		Indicator []RadarIndicator `json:"indicator"`
 	} `json:"radar"`
-	Series   EChartsSeriesList `json:"series"`
-	Children []EChartsOption   `json:"children"`
+	VisualMap EChartsVisualMap  `json:"visualMap"`
+	Series    EChartsSeriesList `json:"series"`
+	Children  []EChartsOption   `json:"children"`
 }
 
 func (eo *EChartsOption) ToOption() ChartOption {
@@ -454,15 +789,17 @@ func (eo *EChartsOption) ToOption() ChartOption {
 			Orient:    eo.Legend.Orient,
 		},
 		RadarIndicators: eo.Radar.Indicator,
+		VisualMap:       eo.VisualMap.ToVisualMapOption(),
 		Width:           eo.Width,
 		Height:          eo.Height,
 		Padding:         eo.Padding.Box,
 		Box:             eo.Box,
-		SeriesList:      eo.Series.ToSeriesList(),
 	}
+	isTimeAxis := len(eo.XAxis.Data) != 0 && eo.XAxis.Data[0].Type == AxisTypeTime
+	o.SeriesList = eo.Series.toSeriesList(isTimeAxis)
 	isHorizontalChart := false
 	for _, item := range eo.XAxis.Data {
-		if item.Type == "value" {
+		if item.Type == AxisTypeValue {
 			isHorizontalChart = true
 		}
 	}
@@ -475,12 +812,29 @@ func (eo *EChartsOption) ToOption() ChartOption {
 		}
 	}
 
+	for _, item := range eo.Series {
+		if item.Type == ChartTypeScatter && item.SymbolSize > 0 {
+			o.SymbolSize = item.SymbolSize
+		}
+		if item.Type == ChartTypeGauge {
+			o.Gauge = item.ToGaugeOption()
+		}
+		if item.Type == ChartTypeSankey {
+			o.SankeyNodes, o.SankeyLinks, o.Sankey = item.ToSankeyOption()
+		}
+	}
+
 	if len(eo.XAxis.Data) != 0 {
 		xAxisData := eo.XAxis.Data[0]
 		o.XAxis = XAxisOption{
 			BoundaryGap: xAxisData.BoundaryGap,
 			Data:        xAxisData.Data,
 			SplitNumber: xAxisData.SplitNumber,
+			Min:         xAxisData.Min,
+			Max:         xAxisData.Max,
+		}
+		if xAxisData.Type == AxisTypeTime {
+			o.XAxis.Type = AxisTypeTime
 		}
 	}
 	yAxisOptions := make([]YAxisOption, len(eo.YAxis.Data))
@@ -492,6 +846,10 @@ func (eo *EChartsOption) ToOption() ChartOption {
 			Color:     parseColor(item.AxisLine.LineStyle.Color),
 			Data:      item.Data,
 		}
+		if item.Type == AxisTypeLog {
+			yAxisOptions[index].Type = AxisTypeLog
+			yAxisOptions[index].LogBase = item.LogBase
+		}
 	}
 	o.YAxisOptions = yAxisOptions
 
@@ -25,6 +25,7 @@ package charts
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/wcharczuk/go-chart/v2/drawing"
@@ -88,6 +89,11 @@ func TestEChartsSeriesData(t *testing.T) {
 			Color: "#a90000",
 		},
 	}, es)
+
+	es = EChartsSeriesData{}
+	err = es.UnmarshalJSON([]byte(`[1, 2, 3]`))
+	assert.Nil(err)
+	assert.Equal(NewEChartsSeriesDataValue(1, 2, 3), es.Value)
 }
 
 func TestEChartsXAxis(t *testing.T) {
@@ -111,6 +117,81 @@ func TestEChartsXAxis(t *testing.T) {
 	}, ex)
 }
 
+func TestEChartsRadius(t *testing.T) {
+	assert := assert.New(t)
+
+	er := EChartsRadius{}
+	err := json.Unmarshal([]byte(`"50%"`), &er)
+	assert.Nil(err)
+	assert.Equal("", er.Inner())
+	assert.Equal("50%", er.Outer())
+
+	er = EChartsRadius{}
+	err = json.Unmarshal([]byte(`["40%", 120]`), &er)
+	assert.Nil(err)
+	assert.Equal("40%", er.Inner())
+	assert.Equal("120", er.Outer())
+
+	// 内半径为0时为饼图
+	er = EChartsRadius{}
+	err = json.Unmarshal([]byte(`[0, "70%"]`), &er)
+	assert.Nil(err)
+	assert.Equal("0", er.Inner())
+	assert.Equal("70%", er.Outer())
+	assert.Equal(0.0, getInnerRadius(100, er.Inner()))
+
+	es := EChartsSeries{}
+	err = json.Unmarshal([]byte(`{"name": "pie", "radius": [0, "70%"]}`), &es)
+	assert.Nil(err)
+	assert.Equal("pie", es.Name)
+	assert.Equal("0", es.InnerRadius)
+	assert.Equal("70%", es.Radius)
+
+	es = EChartsSeries{}
+	err = json.Unmarshal([]byte(`{"radius": "50%"}`), &es)
+	assert.Nil(err)
+	assert.Equal("", es.InnerRadius)
+	assert.Equal("50%", es.Radius)
+}
+
+func TestEChartsRoseType(t *testing.T) {
+	assert := assert.New(t)
+
+	et := EChartsRoseType("")
+	err := json.Unmarshal([]byte(`"area"`), &et)
+	assert.Nil(err)
+	assert.Equal(EChartsRoseType(PieRoseTypeArea), et)
+
+	et = EChartsRoseType("")
+	err = json.Unmarshal([]byte(`true`), &et)
+	assert.Nil(err)
+	assert.Equal(EChartsRoseType(PieRoseTypeRadius), et)
+
+	et = EChartsRoseType("")
+	err = json.Unmarshal([]byte(`false`), &et)
+	assert.Nil(err)
+	assert.Equal(EChartsRoseType(""), et)
+}
+
+func TestEChartsStep(t *testing.T) {
+	assert := assert.New(t)
+
+	es := EChartsStep("")
+	err := json.Unmarshal([]byte(`"middle"`), &es)
+	assert.Nil(err)
+	assert.Equal(EChartsStep(StepMiddle), es)
+
+	es = EChartsStep("")
+	err = json.Unmarshal([]byte(`true`), &es)
+	assert.Nil(err)
+	assert.Equal(EChartsStep(StepStart), es)
+
+	es = EChartsStep("")
+	err = json.Unmarshal([]byte(`false`), &es)
+	assert.Nil(err)
+	assert.Equal(EChartsStep(""), es)
+}
+
 func TestEChartStyle(t *testing.T) {
 	assert := assert.New(t)
 
@@ -130,6 +211,182 @@ func TestEChartStyle(t *testing.T) {
 	}, es.ToStyle())
 }
 
+func TestEChartsVisualMap(t *testing.T) {
+	assert := assert.New(t)
+
+	ev := EChartsVisualMap{}
+	err := json.Unmarshal([]byte(`{
+		"min": 1,
+		"max": 10,
+		"itemHeight": 100,
+		"inRange": {
+			"color": ["#313695", "#a50026"]
+		}
+	}`), &ev)
+	assert.Nil(err)
+	assert.Equal(VisualMapOption{
+		Min:        NewFloatPoint(1),
+		Max:        NewFloatPoint(10),
+		ItemHeight: 100,
+		Colors: []Color{
+			parseColor("#313695"),
+			parseColor("#a50026"),
+		},
+	}, ev.ToVisualMapOption())
+}
+
+func TestEChartsGaugeOption(t *testing.T) {
+	assert := assert.New(t)
+
+	es := EChartsSeries{}
+	err := json.Unmarshal([]byte(`{
+		"type": "gauge",
+		"startAngle": 180,
+		"endAngle": 0,
+		"splitNumber": 5,
+		"axisLine": {
+			"lineStyle": {
+				"color": [
+					[0.3, "#67e0e3"],
+					[1, "#fd666d"]
+				]
+			}
+		}
+	}`), &es)
+	assert.Nil(err)
+	assert.Equal(GaugeOption{
+		StartAngle:  180,
+		EndAngle:    0,
+		SplitNumber: 5,
+		Bands: []GaugeBand{
+			{
+				Percent: 0.3,
+				Color:   parseColor("#67e0e3"),
+			},
+			{
+				Percent: 1,
+				Color:   parseColor("#fd666d"),
+			},
+		},
+	}, es.ToGaugeOption())
+
+	es = EChartsSeries{
+		Type: ChartTypeGauge,
+	}
+	assert.Equal(GaugeOption{
+		StartAngle: 225,
+		EndAngle:   -45,
+	}, es.ToGaugeOption())
+}
+
+func TestEChartsSankeyOption(t *testing.T) {
+	assert := assert.New(t)
+
+	eo := EChartsOption{}
+	err := json.Unmarshal([]byte(`{
+		"series": [
+			{
+				"type": "sankey",
+				"nodeWidth": 10,
+				"nodeGap": 12,
+				"data": [
+					{
+						"name": "a",
+						"itemStyle": {
+							"color": "#bbb"
+						}
+					},
+					{
+						"name": "b"
+					}
+				],
+				"links": [
+					{
+						"source": "a",
+						"target": "b",
+						"value": 5
+					}
+				]
+			}
+		]
+	}`), &eo)
+	assert.Nil(err)
+	opt := eo.ToOption()
+	assert.Empty(opt.SeriesList)
+	assert.Equal([]SankeyNode{
+		{
+			Name:  "a",
+			Color: parseColor("#bbb"),
+		},
+		{
+			Name: "b",
+		},
+	}, opt.SankeyNodes)
+	assert.Equal([]SankeyLink{
+		{
+			Source: "a",
+			Target: "b",
+			Value:  5,
+		},
+	}, opt.SankeyLinks)
+	assert.Equal(SankeyOption{
+		NodeWidth: 10,
+		NodeGap:   12,
+	}, opt.Sankey)
+}
+
+func TestEChartsLineTimeData(t *testing.T) {
+	assert := assert.New(t)
+
+	eo := EChartsOption{}
+	err := json.Unmarshal([]byte(`{
+		"xAxis": {
+			"type": "time"
+		},
+		"series": [
+			{
+				"type": "line",
+				"data": [
+					[1646140380000, 120]
+				]
+			},
+			{
+				"type": "scatter",
+				"data": [
+					[1646140440000, 80]
+				]
+			}
+		]
+	}`), &eo)
+	assert.Nil(err)
+	o := eo.ToOption()
+	assert.Equal(AxisTypeTime, o.XAxis.Type)
+	assert.Equal(120.0, o.SeriesList[0].Data[0].Value)
+	assert.Equal(int64(1646140380000), o.SeriesList[0].Data[0].Time.UnixNano()/int64(time.Millisecond))
+	assert.Equal(80.0, o.SeriesList[1].Data[0].Value)
+	assert.Equal(int64(1646140440000), o.SeriesList[1].Data[0].Time.UnixNano()/int64(time.Millisecond))
+
+	// 非时间轴的[x, y]不作为时间戳
+	eo = EChartsOption{}
+	err = json.Unmarshal([]byte(`{
+		"xAxis": {
+			"data": ["Mon", "Tue"]
+		},
+		"series": [
+			{
+				"type": "line",
+				"data": [
+					[1, 120]
+				]
+			}
+		]
+	}`), &eo)
+	assert.Nil(err)
+	o = eo.ToOption()
+	assert.Equal(1.0, o.SeriesList[0].Data[0].Value)
+	assert.True(o.SeriesList[0].Data[0].Time.IsZero())
+}
+
 func TestEChartsPadding(t *testing.T) {
 	assert := assert.New(t)
 
@@ -460,6 +717,210 @@ func TestEChartsOption(t *testing.T) {
 				]
 			}`,
 		},
+		{
+			option: `{
+				"xAxis": {
+					"type": "value",
+					"min": 0
+				},
+				"yAxis": {
+					"type": "value"
+				},
+				"series": [
+					{
+						"type": "scatter",
+						"symbolSize": 20,
+						"data": [
+							[10.0, 8.04],
+							[8.07, 6.95],
+							[13.0, 7.58],
+							[9.05, 8.81, 20]
+						]
+					}
+				]
+			}`,
+		},
+		{
+			option: `{
+				"xAxis": {
+					"type": "category",
+					"data": ["12a", "1a", "2a"]
+				},
+				"yAxis": {
+					"type": "category",
+					"data": ["Sat", "Fri"]
+				},
+				"visualMap": {
+					"min": 0,
+					"max": 10,
+					"inRange": {
+						"color": ["#313695", "#a50026"]
+					}
+				},
+				"series": [
+					{
+						"type": "heatmap",
+						"data": [
+							[0, 0, 5],
+							[1, 0, 1],
+							[2, 1, 7]
+						]
+					}
+				]
+			}`,
+		},
+		{
+			option: `{
+				"xAxis": {
+					"data": ["2017-10-24", "2017-10-25", "2017-10-26", "2017-10-27"]
+				},
+				"yAxis": {},
+				"series": [
+					{
+						"type": "candlestick",
+						"data": [
+							[20, 34, 10, 38],
+							[40, 35, 30, 50],
+							[31, 38, 33, 44],
+							[38, 15, 5, 42]
+						]
+					}
+				]
+			}`,
+		},
+		{
+			option: `{
+				"xAxis": {
+					"data": ["Mon", "Tue", "Wed"]
+				},
+				"yAxis": {},
+				"series": [
+					{
+						"type": "bar",
+						"stack": "total",
+						"data": [120, 132, 101]
+					},
+					{
+						"type": "bar",
+						"stack": "total",
+						"data": [220, 182, 191]
+					}
+				]
+			}`,
+		},
+		{
+			option: `{
+				"xAxis": {
+					"data": ["Mon", "Tue", "Wed"]
+				},
+				"yAxis": {},
+				"series": [
+					{
+						"type": "line",
+						"step": "middle",
+						"data": [120, 132, 101]
+					}
+				]
+			}`,
+		},
+		{
+			option: `{
+				"xAxis": {
+					"type": "time"
+				},
+				"yAxis": {},
+				"series": [
+					{
+						"type": "line",
+						"data": [
+							[1646140380000, 120],
+							[1646141580000, 132],
+							[1646142180000, 101],
+							[1646145780000, 134]
+						]
+					}
+				]
+			}`,
+		},
+		{
+			option: `{
+				"xAxis": {
+					"data": ["p10", "p50", "p90", "p99"]
+				},
+				"yAxis": {
+					"type": "log",
+					"logBase": 10
+				},
+				"series": [
+					{
+						"type": "line",
+						"data": [1.2, 12, 160, 3200]
+					}
+				]
+			}`,
+		},
+		{
+			option: `{
+				"series": [
+					{
+						"type": "gauge",
+						"min": 0,
+						"max": 200,
+						"detail": {
+							"formatter": "{value} km/h"
+						},
+						"data": [
+							{
+								"value": 120,
+								"name": "Speed"
+							}
+						]
+					}
+				]
+			}`,
+		},
+		{
+			option: `{
+				"series": [
+					{
+						"type": "pie",
+						"radius": ["40%", "70%"],
+						"roseType": "radius",
+						"data": [
+							{
+								"value": 1048,
+								"name": "Search Engine"
+							},
+							{
+								"value": 735,
+								"name": "Direct"
+							}
+						]
+					}
+				]
+			}`,
+		},
+		{
+			option: `{
+				"xAxis": {
+					"data": ["A", "B"]
+				},
+				"series": [
+					{
+						"type": "boxplot",
+						"data": [
+							[20, 40, 50, 60, 90],
+							{
+								"value": [30, 55, 70, 80, 110],
+								"itemStyle": {
+									"color": "#ee6666"
+								}
+							}
+						]
+					}
+				]
+			}`,
+		},
 	}
 	for _, tt := range tests {
 		opt := EChartsOption{}
@@ -578,5 +1039,5 @@ func TestRenderEChartsToSVG(t *testing.T) {
 		]
 	}`)
 	assert.Nil(err)
-	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 182 19\nL 212 19\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><circle cx=\"197\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"214\" y=\"25\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Rainfall</text><path  d=\"M 286 19\nL 316 19\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><circle cx=\"301\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"318\" y=\"25\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Evaporation</text><text x=\"10\" y=\"25\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Rainfall vs Evaporation</text><text x=\"54\" y=\"40\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fake Data</text><text x=\"10\" y=\"67\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">240</text><text x=\"10\" y=\"117\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">200</text><text x=\"10\" y=\"167\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">160</text><text x=\"10\" y=\"217\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"19\" y=\"267\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">80</text><text x=\"19\" y=\"317\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"28\" y=\"367\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path  d=\"M 47 60\nL 570 60\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 110\nL 570 110\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 160\nL 570 160\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 210\nL 570 210\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 260\nL 570 260\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 310\nL 570 310\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 365\nL 47 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 177 365\nL 177 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 308 365\nL 308 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 439 365\nL 439 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 570 365\nL 570 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 47 360\nL 570 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"99\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"227\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"359\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Aug</text><text x=\"490\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Nov</text><path  d=\"M 52 358\nL 67 358\nL 67 359\nL 52 359\nL 52 358\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 95 354\nL 110 354\nL 110 359\nL 95 359\nL 95 354\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 139 352\nL 154 352\nL 154 359\nL 139 359\nL 139 352\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 182 331\nL 197 331\nL 197 359\nL 182 359\nL 182 331\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 226 328\nL 241 328\nL 241 359\nL 226 359\nL 226 328\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 269 265\nL 284 265\nL 284 359\nL 269 359\nL 269 265\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 313 191\nL 328 191\nL 328 359\nL 313 359\nL 313 191\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 357 158\nL 372 158\nL 372 359\nL 357 359\nL 357 158\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 400 320\nL 415 320\nL 415 359\nL 400 359\nL 400 320\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 444 335\nL 459 335\nL 459 359\nL 444 359\nL 444 335\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 487 352\nL 502 352\nL 502 359\nL 487 359\nL 487 352\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 531 356\nL 546 356\nL 546 359\nL 531 359\nL 531 356\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 70 357\nL 85 357\nL 85 359\nL 70 359\nL 70 357\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 113 353\nL 128 353\nL 128 359\nL 113 359\nL 113 353\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 157 349\nL 172 349\nL 172 359\nL 157 359\nL 157 349\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 200 327\nL 215 327\nL 215 359\nL 200 359\nL 200 327\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 244 325\nL 259 325\nL 259 359\nL 244 359\nL 244 325\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 287 272\nL 302 272\nL 302 359\nL 287 359\nL 287 272\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 331 141\nL 346 141\nL 346 359\nL 331 359\nL 331 141\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 375 133\nL 390 133\nL 390 359\nL 375 359\nL 375 133\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 418 300\nL 433 300\nL 433 359\nL 418 359\nL 418 300\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 462 337\nL 477 337\nL 477 359\nL 462 359\nL 462 337\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 505 353\nL 520 353\nL 520 359\nL 505 359\nL 505 353\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 549 358\nL 564 358\nL 564 359\nL 549 359\nL 549 358\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 361 150\nA 15 15 330.00 1 1 367 150\nL 364 136\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 349 136\nQ364,173 379,136\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><text x=\"351\" y=\"141\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">162.2</text><path  d=\"M 56 350\nA 15 15 330.00 1 1 62 350\nL 59 336\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 44 336\nQ59,373 74,336\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><text x=\"55\" y=\"341\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><path  d=\"M 379 125\nA 15 15 330.00 1 1 385 125\nL 382 111\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 367 111\nQ382,148 397,111\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><text x=\"369\" y=\"116\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">182.2</text><path  d=\"M 553 350\nA 15 15 330.00 1 1 559 350\nL 556 336\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 541 336\nQ556,373 571,336\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><text x=\"547\" y=\"341\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text><circle cx=\"50\" cy=\"308\" r=\"3\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 56 308\nL 552 308\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 552 303\nL 568 308\nL 552 313\nL 557 308\nL 552 303\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"570\" y=\"312\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">41.62</text><circle cx=\"50\" cy=\"300\" r=\"3\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 56 300\nL 552 300\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 552 295\nL 568 300\nL 552 305\nL 557 300\nL 552 295\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"570\" y=\"304\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.07</text></svg>", string(data))
+	assert.Equal("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 182 19\nL 212 19\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><circle cx=\"197\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"214\" y=\"25\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Rainfall</text><path  d=\"M 286 19\nL 316 19\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><circle cx=\"301\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"318\" y=\"25\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Evaporation</text><text x=\"10\" y=\"25\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Rainfall vs Evaporation</text><text x=\"54\" y=\"40\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fake Data</text><text x=\"10\" y=\"67\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">200</text><text x=\"10\" y=\"142\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">150</text><text x=\"10\" y=\"217\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><text x=\"19\" y=\"292\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">50</text><text x=\"28\" y=\"367\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path  d=\"M 47 60\nL 570 60\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 135\nL 570 135\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 210\nL 570 210\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 285\nL 570 285\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 365\nL 47 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 177 365\nL 177 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 308 365\nL 308 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 439 365\nL 439 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 570 365\nL 570 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 47 360\nL 570 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"99\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Feb</text><text x=\"227\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">May</text><text x=\"359\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Aug</text><text x=\"490\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Nov</text><path  d=\"M 52 357\nL 67 357\nL 67 359\nL 52 359\nL 52 357\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 95 353\nL 110 353\nL 110 359\nL 95 359\nL 95 353\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 139 350\nL 154 350\nL 154 359\nL 139 359\nL 139 350\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 182 326\nL 197 326\nL 197 359\nL 182 359\nL 182 326\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 226 322\nL 241 322\nL 241 359\nL 226 359\nL 226 322\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 269 245\nL 284 245\nL 284 359\nL 269 359\nL 269 245\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 313 157\nL 328 157\nL 328 359\nL 313 359\nL 313 157\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 357 117\nL 372 117\nL 372 359\nL 357 359\nL 357 117\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 400 312\nL 415 312\nL 415 359\nL 400 359\nL 400 312\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 444 330\nL 459 330\nL 459 359\nL 444 359\nL 444 330\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 487 351\nL 502 351\nL 502 359\nL 487 359\nL 487 351\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 531 356\nL 546 356\nL 546 359\nL 531 359\nL 531 356\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 70 357\nL 85 357\nL 85 359\nL 70 359\nL 70 357\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 113 352\nL 128 352\nL 128 359\nL 113 359\nL 113 352\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 157 347\nL 172 347\nL 172 359\nL 157 359\nL 157 347\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 200 321\nL 215 321\nL 215 359\nL 200 359\nL 200 321\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 244 317\nL 259 317\nL 259 359\nL 244 359\nL 244 317\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 287 254\nL 302 254\nL 302 359\nL 287 359\nL 287 254\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 331 97\nL 346 97\nL 346 359\nL 331 359\nL 331 97\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 375 87\nL 390 87\nL 390 359\nL 375 359\nL 375 87\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 418 287\nL 433 287\nL 433 359\nL 418 359\nL 418 287\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 462 332\nL 477 332\nL 477 359\nL 462 359\nL 462 332\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 505 351\nL 520 351\nL 520 359\nL 505 359\nL 505 351\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 549 357\nL 564 357\nL 564 359\nL 549 359\nL 549 357\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 361 109\nA 15 15 330.00 1 1 367 109\nL 364 95\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 349 95\nQ364,132 379,95\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><text x=\"351\" y=\"100\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">162.2</text><path  d=\"M 56 349\nA 15 15 330.00 1 1 62 349\nL 59 335\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 44 335\nQ59,372 74,335\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><text x=\"55\" y=\"340\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text><path  d=\"M 379 79\nA 15 15 330.00 1 1 385 79\nL 382 65\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 367 65\nQ382,102 397,65\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><text x=\"369\" y=\"70\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:10.2px;font-family:'Roboto Medium',sans-serif\">182.2</text><path  d=\"M 553 349\nA 15 15 330.00 1 1 559 349\nL 556 335\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 541 335\nQ556,372 571,335\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><text x=\"547\" y=\"340\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2.3</text><circle cx=\"50\" cy=\"298\" r=\"3\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 56 298\nL 552 298\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 552 293\nL 568 298\nL 552 303\nL 557 298\nL 552 293\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"570\" y=\"302\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">41.62</text><circle cx=\"50\" cy=\"288\" r=\"3\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 56 288\nL 552 288\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path stroke-dasharray=\"4.0, 2.0\" d=\"M 552 283\nL 568 288\nL 552 293\nL 557 288\nL 552 283\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"570\" y=\"292\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">48.07</text></svg>", string(data))
 }
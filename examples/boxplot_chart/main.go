@@ -0,0 +1,78 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/vicanso/go-charts/v2"
+)
+
+func writeFile(buf []byte) error {
+	tmpPath := "./tmp"
+	err := os.MkdirAll(tmpPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(tmpPath, "boxplot-chart.png")
+	err = os.WriteFile(file, buf, 0600)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	// 各部署版本的请求耗时(ms)
+	samples := [][][]float64{
+		{
+			{
+				82, 95, 101, 110, 118, 120, 125, 131, 140, 152, 260,
+			},
+			{
+				70, 78, 85, 92, 96, 103, 108, 115, 121, 135,
+			},
+			{
+				60, 66, 71, 75, 80, 84, 90, 96, 99, 180, 210,
+			},
+		},
+		{
+			{
+				90, 98, 105, 112, 120, 126, 130, 138, 149, 160,
+			},
+			{
+				75, 80, 88, 95, 99, 106, 110, 119, 128, 140, 30,
+			},
+			{
+				62, 70, 74, 79, 83, 88, 93, 98, 104, 112,
+			},
+		},
+	}
+	p, err := charts.Render(
+		charts.ChartOption{
+			SeriesList: charts.NewBoxPlotSeriesListFromSamples(samples),
+		},
+		charts.TitleTextOptionFunc("Request Latency"),
+		charts.XAxisDataOptionFunc([]string{
+			"v1.0",
+			"v1.1",
+			"v1.2",
+		}),
+		charts.LegendLabelsOptionFunc([]string{
+			"us-east",
+			"eu-west",
+		}, charts.PositionRight),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	buf, err := p.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	err = writeFile(buf)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/vicanso/go-charts/v2"
+)
+
+func writeFile(buf []byte) error {
+	tmpPath := "./tmp"
+	err := os.MkdirAll(tmpPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(tmpPath, "bullet-chart.png")
+	err = os.WriteFile(file, buf, 0600)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	// 实际值、目标值以及差、中、良的区间
+	values := []float64{
+		220,
+		180,
+		260,
+	}
+	targets := []float64{
+		250,
+		200,
+		240,
+	}
+	ranges := [][]float64{
+		{
+			150,
+			225,
+			300,
+		},
+		{
+			120,
+			200,
+			300,
+		},
+		{
+			100,
+			250,
+			300,
+		},
+	}
+	p, err := charts.BulletRender(
+		values,
+		targets,
+		ranges,
+		charts.TitleTextOptionFunc("Q3 KPI"),
+		charts.YAxisDataOptionFunc([]string{
+			"Revenue",
+			"Profit",
+			"Orders",
+		}),
+		charts.PaddingOptionFunc(charts.Box{
+			Top:    20,
+			Right:  40,
+			Bottom: 20,
+			Left:   20,
+		}),
+		func(opt *charts.ChartOption) {
+			opt.SeriesList[0].Label.Show = true
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	buf, err := p.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	err = writeFile(buf)
+	if err != nil {
+		panic(err)
+	}
+}
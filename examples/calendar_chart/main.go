@@ -0,0 +1,65 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/vicanso/go-charts/v2"
+)
+
+func writeFile(buf []byte) error {
+	tmpPath := "./tmp"
+	err := os.MkdirAll(tmpPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(tmpPath, "calendar-chart.png")
+	err = os.WriteFile(file, buf, 0600)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	// 每日的部署次数
+	values := make(map[time.Time]float64)
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.Local)
+	for i := 0; i < 365; i++ {
+		date := start.AddDate(0, 0, i)
+		// 周末不部署
+		if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
+			continue
+		}
+		values[date] = float64((i*7 + i/3) % 9)
+	}
+	p, err := charts.CalendarRender(
+		values,
+		charts.TitleTextOptionFunc("Deployments"),
+		charts.WidthOptionFunc(900),
+		charts.HeightOptionFunc(240),
+		charts.CalendarOptionFunc(charts.CalendarOption{
+			FirstDay: time.Monday,
+		}),
+		charts.PaddingOptionFunc(charts.Box{
+			Top:    20,
+			Right:  20,
+			Bottom: 20,
+			Left:   20,
+		}),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	buf, err := p.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	err = writeFile(buf)
+	if err != nil {
+		panic(err)
+	}
+}
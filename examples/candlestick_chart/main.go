@@ -0,0 +1,77 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/vicanso/go-charts/v2"
+)
+
+func writeFile(buf []byte) error {
+	tmpPath := "./tmp"
+	err := os.MkdirAll(tmpPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(tmpPath, "candlestick-chart.png")
+	err = os.WriteFile(file, buf, 0600)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	// [open, close, lowest, highest]
+	values := [][][]float64{
+		{
+			{2320.26, 2320.26, 2287.3, 2362.94},
+			{2300, 2291.3, 2288.26, 2308.38},
+			{2295.35, 2346.5, 2295.35, 2346.92},
+			{2347.22, 2358.98, 2337.35, 2363.8},
+			{2360.75, 2382.48, 2347.89, 2383.76},
+			{2383.43, 2385.42, 2371.23, 2391.82},
+			{2377.41, 2419.02, 2369.57, 2421.15},
+			{2425.92, 2428.15, 2417.58, 2440.38},
+			{2411, 2433.13, 2403.3, 2437.42},
+			{2432.68, 2434.48, 2427.7, 2441.73},
+			{2430.69, 2418.53, 2394.22, 2433.89},
+			{2416.62, 2432.4, 2414.4, 2443.03},
+			{2441.91, 2421.56, 2415.43, 2444.8},
+			{2420.26, 2382.91, 2373.53, 2427.07},
+			{2383.49, 2397.18, 2370.61, 2397.94},
+			{2378.82, 2325.95, 2309.17, 2378.82},
+			{2322.94, 2314.16, 2308.76, 2330.88},
+			{2320.62, 2325.82, 2315.01, 2338.78},
+			{2313.74, 2293.34, 2289.89, 2340.71},
+			{2297.77, 2313.22, 2292.03, 2324.63},
+		},
+	}
+	p, err := charts.CandlestickRender(
+		values,
+		charts.TitleTextOptionFunc("Candlestick"),
+		charts.XAxisDataOptionFunc([]string{
+			"2013/1/24", "2013/1/25", "2013/1/28", "2013/1/29", "2013/1/30",
+			"2013/1/31", "2013/2/1", "2013/2/4", "2013/2/5", "2013/2/6",
+			"2013/2/7", "2013/2/8", "2013/2/18", "2013/2/19", "2013/2/20",
+			"2013/2/21", "2013/2/22", "2013/2/25", "2013/2/26", "2013/2/27",
+		}),
+		charts.WidthOptionFunc(800),
+		charts.YAxisOptionFunc(charts.YAxisOption{
+			Min: charts.NewFloatPoint(2200),
+		}),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	buf, err := p.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	err = writeFile(buf)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/vicanso/go-charts/v2"
+)
+
+func writeFile(buf []byte) error {
+	tmpPath := "./tmp"
+	err := os.MkdirAll(tmpPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(tmpPath, "error-bar-chart.png")
+	err = os.WriteFile(file, buf, 0600)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	// 平均耗时以及标准差
+	means := []float64{
+		120,
+		95,
+		140,
+		110,
+	}
+	deviations := []float64{
+		12,
+		8,
+		20,
+		5,
+	}
+	lowers := make([]float64, len(means))
+	uppers := make([]float64, len(means))
+	for index, mean := range means {
+		lowers[index] = mean - deviations[index]
+		uppers[index] = mean + deviations[index]
+	}
+	p, err := charts.BarRender(
+		[][]float64{
+			means,
+		},
+		charts.TitleTextOptionFunc("Encoding"),
+		charts.XAxisDataOptionFunc([]string{
+			"json",
+			"gob",
+			"proto",
+			"msgpack",
+		}),
+		func(opt *charts.ChartOption) {
+			opt.SeriesList[0].Data = charts.NewSeriesDataFromBounds(means, lowers, uppers)
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	buf, err := p.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	err = writeFile(buf)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/vicanso/go-charts/v2"
+)
+
+func writeFile(buf []byte) error {
+	tmpPath := "./tmp"
+	err := os.MkdirAll(tmpPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(tmpPath, "gantt-chart.png")
+	err = os.WriteFile(file, buf, 0600)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	day := func(d int) time.Time {
+		return time.Date(2022, 6, d, 0, 0, 0, 0, time.Local)
+	}
+	// 相同分组的任务使用相同的颜色，无结束时间的任务为里程碑
+	tasks := []charts.GanttTask{
+		{
+			Name:  "Design",
+			Start: day(1),
+			End:   day(5),
+			Group: "Plan",
+		},
+		{
+			Name:  "Review",
+			Start: day(6),
+			Group: "Plan",
+		},
+		{
+			Name:  "Backend",
+			Start: day(6),
+			End:   day(18),
+			Group: "Develop",
+		},
+		{
+			Name:  "Frontend",
+			Start: day(8),
+			End:   day(20),
+			Group: "Develop",
+		},
+		{
+			Name:  "Testing",
+			Start: day(18),
+			End:   day(26),
+			Group: "QA",
+		},
+		{
+			Name:  "Release",
+			Start: day(28),
+			Group: "QA",
+		},
+	}
+	p, err := charts.GanttRender(
+		tasks,
+		charts.TitleTextOptionFunc("Project"),
+		charts.PaddingOptionFunc(charts.Box{
+			Top:    20,
+			Right:  20,
+			Bottom: 20,
+			Left:   20,
+		}),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	buf, err := p.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	err = writeFile(buf)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/vicanso/go-charts/v2"
+)
+
+func writeFile(buf []byte) error {
+	tmpPath := "./tmp"
+	err := os.MkdirAll(tmpPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(tmpPath, "gauge-chart.png")
+	err = os.WriteFile(file, buf, 0600)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	p, err := charts.GaugeRender(
+		72.5,
+		charts.TitleTextOptionFunc("Speed"),
+		charts.GaugeOptionFunc(charts.GaugeOption{
+			Bands: []charts.GaugeBand{
+				{
+					Percent: 0.6,
+				},
+				{
+					Percent: 0.85,
+				},
+				{
+					Percent: 1,
+				},
+			},
+		}),
+		func(opt *charts.ChartOption) {
+			opt.SeriesList[0].Name = "km/h"
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	buf, err := p.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	err = writeFile(buf)
+	if err != nil {
+		panic(err)
+	}
+}
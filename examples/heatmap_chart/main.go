@@ -0,0 +1,67 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/vicanso/go-charts/v2"
+)
+
+func writeFile(buf []byte) error {
+	tmpPath := "./tmp"
+	err := os.MkdirAll(tmpPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(tmpPath, "heatmap-chart.png")
+	err = os.WriteFile(file, buf, 0600)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	hours := []string{
+		"12a", "1a", "2a", "3a", "4a", "5a", "6a", "7a",
+		"8a", "9a", "10a", "11a", "12p", "1p", "2p", "3p",
+		"4p", "5p", "6p", "7p", "8p", "9p", "10p", "11p",
+	}
+	days := []string{
+		"Saturday",
+		"Friday",
+		"Thursday",
+		"Wednesday",
+		"Tuesday",
+		"Monday",
+		"Sunday",
+	}
+	// values[y][x]，y对应days，x对应hours
+	values := make([][]float64, len(days))
+	for y := range days {
+		values[y] = make([]float64, len(hours))
+		for x := range hours {
+			values[y][x] = float64((x*7+y*13)%10 + x%3)
+		}
+	}
+	p, err := charts.HeatmapRender(
+		values,
+		charts.TitleTextOptionFunc("Heatmap"),
+		charts.XAxisDataOptionFunc(hours),
+		charts.YAxisDataOptionFunc(days),
+		charts.WidthOptionFunc(800),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	buf, err := p.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	err = writeFile(buf)
+	if err != nil {
+		panic(err)
+	}
+}
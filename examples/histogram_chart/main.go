@@ -0,0 +1,53 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/vicanso/go-charts/v2"
+)
+
+func writeFile(buf []byte) error {
+	tmpPath := "./tmp"
+	err := os.MkdirAll(tmpPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(tmpPath, "histogram-chart.png")
+	err = os.WriteFile(file, buf, 0600)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	// 请求耗时(ms)
+	samples := []float64{
+		62, 75, 81, 88, 92, 95, 98, 101, 103, 104,
+		107, 110, 112, 113, 115, 118, 121, 124, 126, 129,
+		133, 137, 142, 148, 155, 163, 171, 186, 99, 117,
+		105, 111, 119, 122, 109, 96, 131, 139, 127, 114,
+	}
+	p, err := charts.HistogramRender(
+		samples,
+		charts.TitleTextOptionFunc("Request Latency"),
+		charts.HistogramOptionFunc(charts.HistogramOption{
+			BinWidth:   20,
+			Cumulative: true,
+		}),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	buf, err := p.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	err = writeFile(buf)
+	if err != nil {
+		panic(err)
+	}
+}
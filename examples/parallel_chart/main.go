@@ -0,0 +1,82 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/vicanso/go-charts/v2"
+)
+
+func writeFile(buf []byte) error {
+	tmpPath := "./tmp"
+	err := os.MkdirAll(tmpPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(tmpPath, "parallel-chart.png")
+	err = os.WriteFile(file, buf, 0600)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	// latency, cpu, memory, cost
+	values := [][][]float64{
+		{
+			{120, 45, 512, 0.8},
+			{135, 50, 498, 0.9},
+			{110, 42, 530, 0.75},
+			{128, 47, 505, 0.85},
+		},
+		{
+			{80, 70, 820, 1.4},
+			{85, 75, 790, 1.5},
+			{78, 68, 860, 1.35},
+			{90, 72, 800, 1.45},
+		},
+		{
+			{200, 30, 300, 0.5},
+			{210, 28, 320, 0.55},
+			{190, 33, 310, 0.52},
+		},
+	}
+	p, err := charts.ParallelRender(
+		values,
+		charts.TitleTextOptionFunc("Benchmark"),
+		charts.LegendLabelsOptionFunc([]string{
+			"v1.0",
+			"v1.1",
+			"v2.0",
+		}, charts.PositionRight),
+		charts.ParallelAxisOptionFunc([]charts.ParallelAxis{
+			{
+				Name: "Latency(ms)",
+			},
+			{
+				Name: "CPU(%)",
+				Max:  100,
+			},
+			{
+				Name: "Memory(MB)",
+			},
+			{
+				Name: "Cost($/h)",
+			},
+		}),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	buf, err := p.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	err = writeFile(buf)
+	if err != nil {
+		panic(err)
+	}
+}
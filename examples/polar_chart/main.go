@@ -0,0 +1,81 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/vicanso/go-charts/v2"
+)
+
+func writeFile(buf []byte) error {
+	tmpPath := "./tmp"
+	err := os.MkdirAll(tmpPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(tmpPath, "polar-chart.png")
+	err = os.WriteFile(file, buf, 0600)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	// 24小时的请求量
+	hours := make([]string, 24)
+	for index := range hours {
+		hours[index] = strconv.Itoa(index)
+	}
+	values := [][]float64{
+		{
+			32,
+			21,
+			15,
+			12,
+			10,
+			14,
+			35,
+			78,
+			120,
+			145,
+			160,
+			152,
+			138,
+			142,
+			150,
+			148,
+			136,
+			128,
+			110,
+			96,
+			88,
+			72,
+			56,
+			41,
+		},
+	}
+	p, err := charts.BarRender(
+		values,
+		charts.TitleTextOptionFunc("Requests"),
+		charts.XAxisDataOptionFunc(hours),
+		charts.PolarOptionFunc(charts.PolarOption{
+			Radius:      "42%",
+			InnerRadius: "8%",
+		}),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	buf, err := p.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	err = writeFile(buf)
+	if err != nil {
+		panic(err)
+	}
+}
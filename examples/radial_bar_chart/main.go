@@ -0,0 +1,56 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/vicanso/go-charts/v2"
+)
+
+func writeFile(buf []byte) error {
+	tmpPath := "./tmp"
+	err := os.MkdirAll(tmpPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(tmpPath, "radial-bar-chart.png")
+	err = os.WriteFile(file, buf, 0600)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	p, err := charts.RadialBarRender(
+		[]float64{
+			72,
+			45,
+			91,
+			20,
+		},
+		charts.TitleTextOptionFunc("Quota Utilization"),
+		charts.LegendLabelsOptionFunc([]string{
+			"CPU",
+			"Memory",
+			"Disk",
+			"GPU",
+		}),
+		charts.RadialBarOptionFunc(charts.RadialBarOption{
+			RoundCap: true,
+		}),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	buf, err := p.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	err = writeFile(buf)
+	if err != nil {
+		panic(err)
+	}
+}
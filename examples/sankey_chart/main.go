@@ -0,0 +1,65 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/vicanso/go-charts/v2"
+)
+
+func writeFile(buf []byte) error {
+	tmpPath := "./tmp"
+	err := os.MkdirAll(tmpPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(tmpPath, "sankey-chart.png")
+	err = os.WriteFile(file, buf, 0600)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	// 入口流量至后端服务的分布
+	nodes := []charts.SankeyNode{
+		{Name: "ingress"},
+		{Name: "cdn"},
+		{Name: "gateway"},
+		{Name: "users"},
+		{Name: "orders"},
+		{Name: "search"},
+		{Name: "db"},
+		{Name: "cache"},
+	}
+	links := []charts.SankeyLink{
+		{Source: "ingress", Target: "gateway", Value: 70},
+		{Source: "ingress", Target: "cdn", Value: 30},
+		{Source: "gateway", Target: "users", Value: 20},
+		{Source: "gateway", Target: "orders", Value: 35},
+		{Source: "gateway", Target: "search", Value: 15},
+		{Source: "users", Target: "db", Value: 12},
+		{Source: "users", Target: "cache", Value: 8},
+		{Source: "orders", Target: "db", Value: 30},
+		{Source: "search", Target: "cache", Value: 15},
+	}
+	p, err := charts.SankeyRender(
+		nodes,
+		links,
+		charts.TitleTextOptionFunc("Traffic Flow"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	buf, err := p.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	err = writeFile(buf)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/vicanso/go-charts/v2"
+)
+
+func writeFile(buf []byte) error {
+	tmpPath := "./tmp"
+	err := os.MkdirAll(tmpPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(tmpPath, "scatter-chart.png")
+	err = os.WriteFile(file, buf, 0600)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	values := [][][]float64{
+		{
+			{10.0, 8.04},
+			{8.07, 6.95},
+			{13.0, 7.58},
+			{9.05, 8.81},
+			{11.0, 8.33},
+			{14.0, 7.66},
+			{13.4, 6.81},
+			{10.0, 6.33},
+			{14.0, 8.96},
+			{12.5, 6.82},
+			{9.15, 7.2},
+			{11.5, 7.2},
+			{3.03, 4.23},
+			{12.2, 7.83},
+			{2.02, 4.47},
+			{1.05, 3.33},
+			{4.05, 4.96},
+			{6.03, 7.24},
+			{12.0, 6.26},
+			{12.0, 8.84},
+			{7.08, 5.82},
+			{5.02, 5.68},
+		},
+		{
+			// bubble: [x, y, size]
+			{3, 9, 20},
+			{6, 2, 60},
+			{9, 5, 100},
+			{12, 3, 40},
+		},
+	}
+	p, err := charts.ScatterRender(
+		values,
+		charts.TitleTextOptionFunc("Scatter"),
+		charts.LegendLabelsOptionFunc([]string{
+			"Scatter",
+			"Bubble",
+		}, charts.PositionRight),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	buf, err := p.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	err = writeFile(buf)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/vicanso/go-charts/v2"
+)
+
+func writeFile(buf []byte) error {
+	tmpPath := "./tmp"
+	err := os.MkdirAll(tmpPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(tmpPath, "sparkline-chart.png")
+	err = os.WriteFile(file, buf, 0600)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	values := []float64{
+		120,
+		132,
+		101,
+		134,
+		90,
+		230,
+		210,
+		182,
+		191,
+		234,
+		290,
+		330,
+	}
+	p, err := charts.SparklineRender(
+		values,
+		charts.SparklineOptionFunc(charts.SparklineOption{
+			ShowLast: true,
+			ShowMin:  true,
+			ShowMax:  true,
+		}),
+		func(opt *charts.ChartOption) {
+			opt.FillArea = true
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	buf, err := p.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	err = writeFile(buf)
+	if err != nil {
+		panic(err)
+	}
+}
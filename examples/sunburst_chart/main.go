@@ -0,0 +1,79 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/vicanso/go-charts/v2"
+)
+
+func writeFile(buf []byte) error {
+	tmpPath := "./tmp"
+	err := os.MkdirAll(tmpPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(tmpPath, "sunburst-chart.png")
+	err = os.WriteFile(file, buf, 0600)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	// 部门 -> 团队 -> 服务的成本
+	nodes := []charts.SunburstNode{
+		{
+			Name: "Engineering",
+			Children: []charts.SunburstNode{
+				{
+					Name: "Platform",
+					Children: []charts.SunburstNode{
+						{Name: "api", Value: 120},
+						{Name: "db", Value: 80},
+						{Name: "cache", Value: 20},
+					},
+				},
+				{
+					Name: "Mobile",
+					Children: []charts.SunburstNode{
+						{Name: "ios", Value: 60},
+						{Name: "android", Value: 55},
+					},
+				},
+			},
+		},
+		{
+			Name: "Sales",
+			Children: []charts.SunburstNode{
+				{Name: "EMEA", Value: 90},
+				{Name: "APAC", Value: 70},
+				{Name: "NA", Value: 40},
+			},
+		},
+		{Name: "Support", Value: 60},
+		{Name: "Legal", Value: 15},
+	}
+	p, err := charts.SunburstRender(
+		nodes,
+		charts.TitleTextOptionFunc("Cost Attribution"),
+		charts.SunburstOptionFunc(charts.SunburstOption{
+			Radius:      "48%",
+			InnerRadius: "8%",
+		}),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	buf, err := p.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	err = writeFile(buf)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -27,16 +27,15 @@ func writeFile(buf []byte) error {
 }
 
 func main() {
-	xAxisValue := []string{}
+	times := []time.Time{}
 	values := []float64{}
 	now := time.Now()
-	firstAxis := 0
 	for i := 0; i < 300; i++ {
-		// 设置首个axis为xx:00的时间点
-		if firstAxis == 0 && now.Minute() == 0 {
-			firstAxis = i
+		// 模拟不均匀的采样间隔
+		if i%50 == 0 {
+			now = now.Add(10 * time.Minute)
 		}
-		xAxisValue = append(xAxisValue, now.Format("15:04"))
+		times = append(times, now)
 		now = now.Add(time.Minute)
 		value, _ := rand.Int(rand.Reader, big.NewInt(100))
 		values = append(values, float64(value.Int64()))
@@ -46,14 +45,12 @@ func main() {
 			values,
 		},
 		charts.TitleTextOptionFunc("Line"),
-		charts.XAxisDataOptionFunc(xAxisValue, charts.FalseFlag()),
+		// 时间轴根据时间范围自动生成刻度
+		charts.XAxisTimeOptionFunc(times, time.Local),
 		charts.LegendLabelsOptionFunc([]string{
 			"Demo",
 		}, "50"),
 		func(opt *charts.ChartOption) {
-			opt.XAxis.FirstAxis = firstAxis
-			// 必须要比计算得来的最小值更大(每60分钟)
-			opt.XAxis.SplitNumber = 60
 			opt.Legend.Padding = charts.Box{
 				Top:    5,
 				Bottom: 10,
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/vicanso/go-charts/v2"
+)
+
+func writeFile(buf []byte) error {
+	tmpPath := "./tmp"
+	err := os.MkdirAll(tmpPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(tmpPath, "treemap-chart.png")
+	err = os.WriteFile(file, buf, 0600)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	// 各服务每月成本
+	nodes := []charts.TreemapNode{
+		{
+			Name: "api",
+			Children: []charts.TreemapNode{
+				{Name: "gateway", Value: 320},
+				{Name: "auth service", Value: 120},
+				{Name: "billing", Value: 80},
+			},
+		},
+		{
+			Name: "storage",
+			Children: []charts.TreemapNode{
+				{Name: "s3 buckets", Value: 240},
+				{Name: "ebs volumes", Value: 90},
+				{Name: "snapshots", Value: 30},
+			},
+		},
+		{
+			Name: "compute",
+			Children: []charts.TreemapNode{
+				{Name: "batch", Value: 150},
+				{
+					Name: "workers",
+					Children: []charts.TreemapNode{
+						{Name: "queue-a", Value: 90},
+						{Name: "queue-b", Value: 60},
+						{Name: "queue-c", Value: 10},
+					},
+				},
+			},
+		},
+		{Name: "monitoring", Value: 70},
+		{Name: "dns", Value: 8},
+	}
+	p, err := charts.TreemapRender(
+		nodes,
+		charts.TitleTextOptionFunc("Monthly Cost"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	buf, err := p.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	err = writeFile(buf)
+	if err != nil {
+		panic(err)
+	}
+}
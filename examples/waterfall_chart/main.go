@@ -0,0 +1,73 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/vicanso/go-charts/v2"
+)
+
+func writeFile(buf []byte) error {
+	tmpPath := "./tmp"
+	err := os.MkdirAll(tmpPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(tmpPath, "waterfall-chart.png")
+	err = os.WriteFile(file, buf, 0600)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	// 利润构成，第5项为毛利，最后一项为净利润
+	values := []float64{
+		420,
+		180,
+		-90,
+		-60,
+		0,
+		120,
+		-200,
+		0,
+	}
+	p, err := charts.WaterfallRender(
+		values,
+		[]int{4, 7},
+		charts.TitleTextOptionFunc("Profit"),
+		charts.XAxisDataOptionFunc([]string{
+			"Revenue",
+			"Services",
+			"COGS",
+			"Opex",
+			"Gross",
+			"Other",
+			"Tax",
+			"Net",
+		}),
+		charts.PaddingOptionFunc(charts.Box{
+			Top:    20,
+			Right:  20,
+			Bottom: 20,
+			Left:   20,
+		}),
+		func(opt *charts.ChartOption) {
+			opt.SeriesList[0].Label.Show = true
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	buf, err := p.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	err = writeFile(buf)
+	if err != nil {
+		panic(err)
+	}
+}
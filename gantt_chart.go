@@ -0,0 +1,244 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"time"
+
+	"github.com/golang/freetype/truetype"
+)
+
+type ganttChart struct {
+	p   *Painter
+	opt *GanttChartOption
+}
+
+type GanttTask struct {
+	// The name of task
+	Name string
+	// The start time of task
+	Start time.Time
+	// The end time of task, the task is a milestone if it's zero or equal to start time
+	End time.Time
+	// The group of task, the tasks of the same group are a series
+	Group string
+}
+
+// GanttData is the data of gantt chart, the start time of task is the time of series data
+type GanttData struct {
+	// The end time of task
+	EndTime time.Time
+}
+
+// ganttEndTime returns the end time of gantt task, it's zero if it's not set
+func (item *SeriesData) ganttEndTime() time.Time {
+	if item.Gantt == nil {
+		return time.Time{}
+	}
+	return item.Gantt.EndTime
+}
+
+// NewGanttSeriesList returns the series list and the task names of gantt chart,
+// each group is a series, and the tasks are sorted by the first appearance of group
+func NewGanttSeriesList(tasks []GanttTask) (SeriesList, []string) {
+	seriesList := make(SeriesList, 0)
+	groupTasks := make(map[string][]GanttTask)
+	for _, task := range tasks {
+		if _, ok := groupTasks[task.Group]; !ok {
+			seriesList = append(seriesList, Series{
+				Type: ChartTypeGantt,
+				Name: task.Group,
+			})
+		}
+		groupTasks[task.Group] = append(groupTasks[task.Group], task)
+	}
+	names := make([]string, 0, len(tasks))
+	for index, series := range seriesList {
+		data := make([]SeriesData, 0)
+		for _, task := range groupTasks[series.Name] {
+			item := SeriesData{
+				Time: task.Start,
+			}
+			// 里程碑无结束时间
+			if !task.End.IsZero() {
+				item.Gantt = &GanttData{
+					EndTime: task.End,
+				}
+			}
+			data = append(data, item)
+			names = append(names, task.Name)
+		}
+		seriesList[index].Data = data
+	}
+	return seriesList, names
+}
+
+// ganttYAxisOption returns the category y axis option of gantt chart,
+// the data is reversed to show the first task at the top
+func ganttYAxisOption(opt YAxisOption) YAxisOption {
+	data := append([]string{}, opt.Data...)
+	reverseStringSlice(data)
+	opt.Data = data
+	opt.DivideCount = len(data)
+	opt.Unit = 1
+	return opt
+}
+
+// NewGanttChart returns a gantt chart renderer
+func NewGanttChart(p *Painter, opt GanttChartOption) *ganttChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &ganttChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+type GanttChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The data series list
+	SeriesList SeriesList
+	// The x axis option
+	XAxis XAxisOption
+	// The padding of gantt chart
+	Padding Box
+	// The y axis option, the data of first y axis is the task names
+	YAxisOptions []YAxisOption
+	// The option of title
+	Title TitleOption
+	// The legend option
+	Legend LegendOption
+	// The height of task bar
+	BarHeight int
+}
+
+func (g *ganttChart) render(result *defaultRenderResult, seriesList SeriesList) (Box, error) {
+	p := g.p
+	opt := g.opt
+	seriesPainter := result.seriesPainter
+
+	count := 0
+	for _, series := range seriesList {
+		count += len(series.Data)
+	}
+	if len(opt.YAxisOptions) != 0 && len(opt.YAxisOptions[0].Data) > count {
+		count = len(opt.YAxisOptions[0].Data)
+	}
+	if count == 0 {
+		return p.box, nil
+	}
+	yValues := autoDivide(seriesPainter.Height(), count)
+	rowHeight := yValues[1] - yValues[0]
+	margin := rowHeight / 5
+	if opt.BarHeight > 0 && opt.BarHeight < rowHeight {
+		margin = (rowHeight - opt.BarHeight) / 2
+	}
+	xRange := result.xAxisRange
+	theme := opt.Theme
+
+	// 任务按顺序由上至下展示
+	row := 0
+	for _, series := range seriesList {
+		seriesColor := theme.GetSeriesColor(series.index)
+		for _, item := range series.Data {
+			if row >= count {
+				break
+			}
+			top := yValues[row] + margin
+			bottom := yValues[row+1] - margin
+			row++
+			if item.Time.IsZero() {
+				continue
+			}
+			color := seriesColor
+			if !item.Style.FillColor.IsZero() {
+				color = item.Style.FillColor
+			}
+			start := xRange.getHeight(timeToValue(item.Time))
+			// 里程碑以菱形展示
+			endTime := item.ganttEndTime()
+			if !endTime.After(item.Time) {
+				center := (top + bottom) >> 1
+				size := (bottom - top) >> 1
+				seriesPainter.OverrideDrawingStyle(Style{
+					StrokeWidth: 1,
+					StrokeColor: color,
+					FillColor:   color,
+				})
+				seriesPainter.MoveTo(start, center-size)
+				seriesPainter.LineTo(start+size, center)
+				seriesPainter.LineTo(start, center+size)
+				seriesPainter.LineTo(start-size, center)
+				seriesPainter.Close()
+				seriesPainter.FillStroke()
+				continue
+			}
+			end := xRange.getHeight(timeToValue(endTime))
+			// 至少展示1px
+			if end <= start {
+				end = start + 1
+			}
+			seriesPainter.OverrideDrawingStyle(Style{
+				FillColor: color,
+			}).Rect(Box{
+				Top:    top,
+				Left:   start,
+				Right:  end,
+				Bottom: bottom,
+			})
+		}
+	}
+
+	return p.box, nil
+}
+
+func (g *ganttChart) Render() (Box, error) {
+	p := g.p
+	opt := g.opt
+	yAxisOptions := append([]YAxisOption{}, opt.YAxisOptions...)
+	if len(yAxisOptions) == 0 {
+		yAxisOptions = append(yAxisOptions, YAxisOption{})
+	}
+	yAxisOptions[0] = ganttYAxisOption(yAxisOptions[0])
+	xAxis := opt.XAxis
+	xAxis.Type = AxisTypeTime
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		Theme:           opt.Theme,
+		Padding:         opt.Padding,
+		SeriesList:      opt.SeriesList,
+		XAxis:           xAxis,
+		YAxisOptions:    yAxisOptions,
+		TitleOption:     opt.Title,
+		LegendOption:    opt.Legend,
+		yAxisIsCategory: true,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	seriesList := opt.SeriesList.Filter(ChartTypeGantt)
+	return g.render(renderResult, seriesList)
+}
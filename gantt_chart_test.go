@@ -0,0 +1,200 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGanttSeriesList(t *testing.T) {
+	assert := assert.New(t)
+
+	day := func(d int) time.Time {
+		return time.Date(2022, 6, d, 0, 0, 0, 0, time.UTC)
+	}
+	seriesList, names := NewGanttSeriesList([]GanttTask{
+		{
+			Name:  "Design",
+			Start: day(1),
+			End:   day(5),
+			Group: "Plan",
+		},
+		{
+			Name:  "Backend",
+			Start: day(6),
+			End:   day(18),
+			Group: "Dev",
+		},
+		{
+			Name:  "Review",
+			Start: day(6),
+			Group: "Plan",
+		},
+	})
+	assert.Equal([]string{
+		"Design",
+		"Review",
+		"Backend",
+	}, names)
+	assert.Equal(SeriesList{
+		{
+			Type: ChartTypeGantt,
+			Name: "Plan",
+			Data: []SeriesData{
+				{
+					Time: day(1),
+					Gantt: &GanttData{
+						EndTime: day(5),
+					},
+				},
+				{
+					Time: day(6),
+				},
+			},
+		},
+		{
+			Type: ChartTypeGantt,
+			Name: "Dev",
+			Data: []SeriesData{
+				{
+					Time: day(6),
+					Gantt: &GanttData{
+						EndTime: day(18),
+					},
+				},
+			},
+		},
+	}, seriesList)
+
+	max, min := seriesList.GetTimeMaxMin()
+	assert.Equal(day(18), max)
+	assert.Equal(day(1), min)
+}
+
+func TestGanttYAxisOption(t *testing.T) {
+	assert := assert.New(t)
+
+	data := []string{
+		"a",
+		"b",
+		"c",
+	}
+	opt := ganttYAxisOption(YAxisOption{
+		Data: data,
+	})
+	assert.Equal([]string{
+		"c",
+		"b",
+		"a",
+	}, opt.Data)
+	assert.Equal(3, opt.DivideCount)
+	assert.Equal(1, opt.Unit)
+	// 不修改原有数据
+	assert.Equal("a", data[0])
+}
+
+func TestGanttChart(t *testing.T) {
+	assert := assert.New(t)
+
+	day := func(d int) time.Time {
+		return time.Date(2022, 6, d, 0, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		render func(*Painter) ([]byte, error)
+		result string
+	}{
+		{
+			render: func(p *Painter) ([]byte, error) {
+				seriesList, names := NewGanttSeriesList([]GanttTask{
+					{
+						Name:  "Design",
+						Start: day(1),
+						End:   day(5),
+						Group: "Plan",
+					},
+					{
+						Name:  "Review",
+						Start: day(6),
+						Group: "Plan",
+					},
+					{
+						Name:  "Backend",
+						Start: day(6),
+						End:   day(18),
+						Group: "Dev",
+					},
+					{
+						Name:  "Testing",
+						Start: day(18),
+						End:   day(26),
+						Group: "QA",
+					},
+				})
+				seriesList[2].Data[0].Style.FillColor = parseColor("#bbb")
+				_, err := NewGanttChart(p, GanttChartOption{
+					Title: TitleOption{
+						Text: "Project",
+					},
+					Padding: Box{
+						Top:    10,
+						Right:  10,
+						Bottom: 10,
+						Left:   10,
+					},
+					SeriesList: seriesList,
+					YAxisOptions: []YAxisOption{
+						{
+							Data: names,
+						},
+					},
+					Legend: NewLegendOption([]string{
+						"Plan",
+						"Dev",
+						"QA",
+					}),
+					BarHeight: 20,
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 194 19\nL 224 19\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><circle cx=\"209\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"226\" y=\"25\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Plan</text><path  d=\"M 277 19\nL 307 19\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><circle cx=\"292\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"309\" y=\"25\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Dev</text><path  d=\"M 355 19\nL 385 19\" style=\"stroke-width:3;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><circle cx=\"370\" cy=\"19\" r=\"5\" style=\"stroke-width:3;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><text x=\"387\" y=\"25\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">QA</text><text x=\"10\" y=\"25\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Project</text><path  d=\"M 75 45\nL 80 45\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 75 123\nL 80 123\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 75 202\nL 80 202\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 75 281\nL 80 281\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 75 360\nL 80 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 80 45\nL 80 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"22\" y=\"91\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Design</text><text x=\"21\" y=\"169\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Review</text><text x=\"10\" y=\"248\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Backend</text><text x=\"18\" y=\"327\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Testing</text><path  d=\"M 182 365\nL 182 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 324 365\nL 324 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 467 365\nL 467 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 80 360\nL 590 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"162\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">06-06</text><text x=\"304\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">06-13</text><text x=\"447\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">06-20</text><path  d=\"M 80 74\nL 161 74\nL 161 94\nL 80 94\nL 80 74\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 182 152\nL 192 162\nL 182 172\nL 172 162\nZ\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"M 182 231\nL 426 231\nL 426 252\nL 182 252\nL 182 231\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><path  d=\"M 426 310\nL 590 310\nL 590 331\nL 426 331\nL 426 310\" style=\"stroke-width:0;stroke:none;fill:rgba(187,187,187,1.0)\"/></svg>",
+		},
+	}
+
+	for _, tt := range tests {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		data, err := tt.render(p)
+		assert.Nil(err)
+		assert.Equal(tt.result, string(data))
+	}
+}
@@ -0,0 +1,354 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"math"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+type gaugeChart struct {
+	p   *Painter
+	opt *GaugeChartOption
+}
+
+// NewGaugeSeriesList returns a series list for gauge, each value is a pointer
+func NewGaugeSeriesList(values []float64) SeriesList {
+	seriesList := make(SeriesList, len(values))
+	for index, value := range values {
+		seriesList[index] = NewSeriesFromValues([]float64{
+			value,
+		}, ChartTypeGauge)
+	}
+	return seriesList
+}
+
+// NewGaugeChart returns a gauge chart renderer
+func NewGaugeChart(p *Painter, opt GaugeChartOption) *gaugeChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &gaugeChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+type GaugeBand struct {
+	// The end of band, it's the percent of range (0-1)
+	Percent float64
+	// The color of band, default is the series color of theme
+	Color Color
+}
+
+type GaugeOption struct {
+	// The start angle of dial (degree, counterclockwise from 3 o'clock), default is 225
+	StartAngle float64
+	// The end angle of dial, default is -45
+	EndAngle float64
+	// The split number of dial, default is 10
+	SplitNumber int
+	// The color bands of dial, default is [0.3, 0.7, 1]
+	Bands []GaugeBand
+}
+
+type GaugeChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The data series list, the min and max of the first series are the range of dial
+	SeriesList SeriesList
+	// The padding of gauge chart
+	Padding Box
+	// The option of title
+	Title TitleOption
+	// The option of gauge
+	Gauge GaugeOption
+	// background is filled
+	backgroundIsFilled bool
+}
+
+const defaultGaugeSplitNumber = 10
+
+var defaultGaugeBandPercents = []float64{
+	0.3,
+	0.7,
+	1,
+}
+
+// getAngles returns the start angle and sweep of dial in screen radians
+func (opt *GaugeOption) getAngles() (float64, float64) {
+	startAngle := opt.StartAngle
+	endAngle := opt.EndAngle
+	if startAngle == endAngle {
+		startAngle = 225
+		endAngle = -45
+	}
+	sweep := startAngle - endAngle
+	for sweep <= 0 {
+		sweep += 360
+	}
+	if sweep > 360 {
+		sweep = 360
+	}
+	// 屏幕坐标为顺时针方向，因此需要取反
+	return -startAngle * math.Pi / 180, sweep * math.Pi / 180
+}
+
+// getBands returns the bands of dial, the color is filled from theme if not set
+func (opt *GaugeOption) getBands(theme ColorPalette) []GaugeBand {
+	bands := opt.Bands
+	if len(bands) == 0 {
+		bands = make([]GaugeBand, len(defaultGaugeBandPercents))
+		for index, percent := range defaultGaugeBandPercents {
+			bands[index] = GaugeBand{
+				Percent: percent,
+			}
+		}
+	}
+	result := make([]GaugeBand, len(bands))
+	for index, band := range bands {
+		if band.Color.IsZero() {
+			band.Color = theme.GetSeriesColor(index + 1)
+		}
+		result[index] = band
+	}
+	return result
+}
+
+// getArcBottom returns the max sin value of arc, it's the bottom of dial
+func getArcBottom(startAngle, sweep float64) float64 {
+	bottom := math.Max(math.Sin(startAngle), math.Sin(startAngle+sweep))
+	// 如果经过π/2，则最低点为圆的底部
+	k := math.Ceil((startAngle - math.Pi/2) / (2 * math.Pi))
+	if math.Pi/2+k*2*math.Pi <= startAngle+sweep {
+		bottom = 1
+	}
+	return math.Max(bottom, 0)
+}
+
+func getGaugeBandColor(bands []GaugeBand, percent float64) Color {
+	for _, band := range bands {
+		if percent <= band.Percent {
+			return band.Color
+		}
+	}
+	return bands[len(bands)-1].Color
+}
+
+func (g *gaugeChart) render(result *defaultRenderResult, seriesList SeriesList) (Box, error) {
+	opt := g.opt
+	if len(seriesList) == 0 {
+		return BoxZero, nil
+	}
+	theme := opt.Theme
+	seriesPainter := result.seriesPainter
+
+	min := 0.0
+	max := 100.0
+	first := seriesList[0]
+	if first.Min != nil {
+		min = *first.Min
+	}
+	if first.Max != nil {
+		max = *first.Max
+	}
+	if max <= min {
+		max = min + 1
+	}
+	getPercent := func(value float64) float64 {
+		percent := (value - min) / (max - min)
+		return math.Max(math.Min(percent, 1), 0)
+	}
+
+	cx := seriesPainter.Width() >> 1
+	cy := seriesPainter.Height() >> 1
+	diameter := chart.MinInt(seriesPainter.Width(), seriesPainter.Height())
+	radius := getRadius(float64(diameter), first.Radius)
+
+	startAngle, sweep := opt.Gauge.getAngles()
+	splitNumber := opt.Gauge.SplitNumber
+	if splitNumber <= 0 {
+		splitNumber = defaultGaugeSplitNumber
+	}
+	bands := opt.Gauge.getBands(theme)
+	lineWidth := math.Max(radius/12, 4)
+
+	// 色带
+	prevPercent := 0.0
+	for _, band := range bands {
+		percent := math.Min(band.Percent, 1)
+		if percent <= prevPercent {
+			continue
+		}
+		seriesPainter.OverrideDrawingStyle(Style{
+			StrokeWidth: lineWidth,
+			StrokeColor: band.Color,
+		})
+		r := radius - lineWidth/2
+		seriesPainter.ArcTo(cx, cy, r, r, startAngle+prevPercent*sweep, (percent-prevPercent)*sweep)
+		seriesPainter.Stroke()
+		prevPercent = percent
+	}
+
+	// 刻度及刻度值
+	textColor := theme.GetTextColor()
+	seriesPainter.OverrideDrawingStyle(Style{
+		StrokeWidth: 1,
+		StrokeColor: theme.GetAxisStrokeColor(),
+	})
+	seriesPainter.OverrideTextStyle(Style{
+		FontColor: textColor,
+		FontSize:  labelFontSize,
+		Font:      opt.Font,
+	})
+	tickStart := radius - lineWidth - 2
+	tickEnd := tickStart - math.Max(radius/15, 5)
+	labelRadius := tickEnd - labelFontSize
+	for i := 0; i <= splitNumber; i++ {
+		// 完整的圆则不再绘制结束刻度
+		if i == splitNumber && sweep >= 2*math.Pi {
+			break
+		}
+		percent := float64(i) / float64(splitNumber)
+		angle := startAngle + percent*sweep
+		cos := math.Cos(angle)
+		sin := math.Sin(angle)
+		seriesPainter.MoveTo(cx+int(tickStart*cos), cy+int(tickStart*sin))
+		seriesPainter.LineTo(cx+int(tickEnd*cos), cy+int(tickEnd*sin))
+		seriesPainter.Stroke()
+
+		text := commafWithDigits(min + percent*(max-min))
+		textBox := seriesPainter.MeasureText(text)
+		x := cx + int(labelRadius*cos) - textBox.Width()>>1
+		y := cy + int(labelRadius*sin) + textBox.Height()>>1
+		seriesPainter.Text(text, x, y)
+	}
+
+	// 指针
+	pointerLength := radius * 0.6
+	pointerWidth := math.Max(radius/30, 3)
+	seriesNames := seriesList.Names()
+	for index, series := range seriesList {
+		if len(series.Data) == 0 {
+			continue
+		}
+		value := series.Data[0].Value
+		percent := getPercent(value)
+		color := getGaugeBandColor(bands, percent)
+		if len(seriesList) > 1 {
+			color = theme.GetSeriesColor(series.index)
+		}
+		angle := startAngle + percent*sweep
+		cos := math.Cos(angle)
+		sin := math.Sin(angle)
+		points := []Point{
+			{
+				X: cx + int(pointerLength*cos),
+				Y: cy + int(pointerLength*sin),
+			},
+			{
+				X: cx + int(pointerWidth*sin),
+				Y: cy - int(pointerWidth*cos),
+			},
+			{
+				X: cx - int(2*pointerWidth*cos),
+				Y: cy - int(2*pointerWidth*sin),
+			},
+			{
+				X: cx - int(pointerWidth*sin),
+				Y: cy + int(pointerWidth*cos),
+			},
+		}
+		points = append(points, points[0])
+		seriesPainter.OverrideDrawingStyle(Style{
+			StrokeWidth: 1,
+			StrokeColor: color,
+			FillColor:   color,
+		})
+		seriesPainter.FillArea(points)
+		seriesPainter.Circle(pointerWidth*1.5, cx, cy)
+		seriesPainter.FillStroke()
+
+		// 仅第一个指针展示详细值
+		if index != 0 {
+			continue
+		}
+		fontColor := textColor
+		if !series.Label.Color.IsZero() {
+			fontColor = series.Label.Color
+		}
+		// 展示于表盘两端刻度值之下
+		detailTop := cy + int(radius*math.Max(getArcBottom(startAngle, sweep)*0.88, 0.3))
+		text := NewValueLabelFormatter(seriesNames, series.Label.Formatter)(index, value, percent)
+		seriesPainter.OverrideTextStyle(Style{
+			FontColor: fontColor,
+			FontSize:  1.5 * theme.GetFontSize(),
+			Font:      opt.Font,
+		})
+		textBox := seriesPainter.MeasureText(text)
+		seriesPainter.Text(text, cx-textBox.Width()>>1, detailTop+textBox.Height())
+		if name := seriesNames[index]; name != "" {
+			seriesPainter.OverrideTextStyle(Style{
+				FontColor: fontColor,
+				FontSize:  theme.GetFontSize(),
+				Font:      opt.Font,
+			})
+			textBox := seriesPainter.MeasureText(name)
+			seriesPainter.Text(name, cx-textBox.Width()>>1, detailTop-5)
+		}
+	}
+
+	return g.p.box, nil
+}
+
+func (g *gaugeChart) Render() (Box, error) {
+	opt := g.opt
+
+	renderResult, err := defaultRender(g.p, defaultRenderOption{
+		Theme:      opt.Theme,
+		Padding:    opt.Padding,
+		SeriesList: opt.SeriesList,
+		XAxis: XAxisOption{
+			Show: FalseFlag(),
+		},
+		YAxisOptions: []YAxisOption{
+			{
+				Show: FalseFlag(),
+			},
+		},
+		TitleOption: opt.Title,
+		LegendOption: LegendOption{
+			Show: FalseFlag(),
+		},
+		backgroundIsFilled: opt.backgroundIsFilled,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	seriesList := opt.SeriesList.Filter(ChartTypeGauge)
+	return g.render(renderResult, seriesList)
+}
@@ -0,0 +1,184 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"github.com/golang/freetype/truetype"
+)
+
+type heatmapChart struct {
+	p   *Painter
+	opt *HeatmapChartOption
+}
+
+// NewHeatmapSeriesList returns a series list for heatmap chart,
+// each row of values is the data of a y axis category
+func NewHeatmapSeriesList(values [][]float64) SeriesList {
+	return NewSeriesListDataFromValues(values, ChartTypeHeatmap)
+}
+
+// NewHeatmapChart returns a heatmap chart renderer
+func NewHeatmapChart(p *Painter, opt HeatmapChartOption) *heatmapChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &heatmapChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+type HeatmapChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The data series list
+	SeriesList SeriesList
+	// The x axis option
+	XAxis XAxisOption
+	// The padding of heatmap chart
+	Padding Box
+	// The y axis option, the data of first y axis is the category list
+	YAxisOptions []YAxisOption
+	// The option of title
+	Title TitleOption
+	// The visual map option
+	VisualMap VisualMapOption
+	// background is filled
+	backgroundIsFilled bool
+}
+
+func (h *heatmapChart) render(result *defaultRenderResult, seriesList SeriesList) (Box, error) {
+	p := h.p
+	opt := h.opt
+	seriesPainter := result.seriesPainter
+
+	xCount := len(opt.XAxis.Data)
+	yCount := len(seriesList)
+	if len(opt.YAxisOptions) != 0 && len(opt.YAxisOptions[0].Data) > yCount {
+		yCount = len(opt.YAxisOptions[0].Data)
+	}
+	if xCount == 0 || yCount == 0 {
+		return p.box, nil
+	}
+	visualMap := opt.VisualMap
+	visualMap.fillDefault(seriesList)
+
+	xValues := autoDivide(seriesPainter.Width(), xCount)
+	yValues := autoDivide(seriesPainter.Height(), yCount)
+	seriesNames := seriesList.Names()
+	for index, series := range seriesList {
+		// 第一个分类在最下方
+		row := yCount - index - 1
+		for j, item := range series.Data {
+			if j >= xCount || item.Value == nullValue {
+				continue
+			}
+			fillColor := visualMap.GetColor(item.Value)
+			if !item.Style.FillColor.IsZero() {
+				fillColor = item.Style.FillColor
+			}
+			box := Box{
+				Top:    yValues[row],
+				Left:   xValues[j],
+				Right:  xValues[j+1],
+				Bottom: yValues[row+1],
+			}
+			seriesPainter.OverrideDrawingStyle(Style{
+				FillColor: fillColor,
+			}).Rect(box)
+
+			if !series.Label.Show {
+				continue
+			}
+			fontColor := series.Label.Color
+			if fontColor.IsZero() {
+				if isLightColor(fillColor) {
+					fontColor = defaultLightFontColor
+				} else {
+					fontColor = defaultDarkFontColor
+				}
+			}
+			fontSize := series.Label.FontSize
+			if fontSize == 0 {
+				fontSize = labelFontSize
+			}
+			text := NewValueLabelFormatter(seriesNames, series.Label.Formatter)(index, item.Value, -1)
+			seriesPainter.OverrideTextStyle(Style{
+				Font:      opt.Font,
+				FontSize:  fontSize,
+				FontColor: fontColor,
+			})
+			textBox := seriesPainter.MeasureText(text)
+			// 空间不足则不展示
+			if textBox.Width() > box.Width() || textBox.Height() > box.Height() {
+				continue
+			}
+			x := box.Left + (box.Width()-textBox.Width())>>1
+			y := box.Top + (box.Height()+textBox.Height())>>1
+			seriesPainter.Text(text, x, y)
+		}
+	}
+	return p.box, nil
+}
+
+func (h *heatmapChart) Render() (Box, error) {
+	p := h.p
+	opt := h.opt
+
+	opt.VisualMap.fillDefault(opt.SeriesList)
+	opt.XAxis.BoundaryGap = TrueFlag()
+	yAxisOptions := make([]YAxisOption, 1)
+	copy(yAxisOptions, opt.YAxisOptions)
+	yAxisOptions[0] = heatmapYAxisOption(yAxisOptions[0], opt.SeriesList)
+	opt.YAxisOptions = yAxisOptions
+
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		Theme:              opt.Theme,
+		Padding:            opt.Padding,
+		SeriesList:         opt.SeriesList,
+		XAxis:              opt.XAxis,
+		YAxisOptions:       yAxisOptions,
+		TitleOption:        opt.Title,
+		VisualMapOption:    &opt.VisualMap,
+		backgroundIsFilled: opt.backgroundIsFilled,
+		yAxisIsCategory:    true,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	seriesList := opt.SeriesList.Filter(ChartTypeHeatmap)
+	return h.render(renderResult, seriesList)
+}
+
+// heatmapYAxisOption sets the category list of y axis,
+// the names of series are used if the data is empty
+func heatmapYAxisOption(opt YAxisOption, seriesList SeriesList) YAxisOption {
+	if len(opt.Data) == 0 {
+		opt.Data = seriesList.Names()
+	}
+	opt.DivideCount = len(opt.Data)
+	opt.Unit = 1
+	return opt
+}
@@ -0,0 +1,99 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeatmapChart(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		render func(*Painter) ([]byte, error)
+		result string
+	}{
+		{
+			render: func(p *Painter) ([]byte, error) {
+				seriesList := NewHeatmapSeriesList([][]float64{
+					{
+						1,
+						5,
+						3,
+					},
+					{
+						8,
+						nullValue,
+						2,
+					},
+				})
+				for index := range seriesList {
+					seriesList[index].Label.Show = true
+				}
+				_, err := NewHeatmapChart(p, HeatmapChartOption{
+					Title: TitleOption{
+						Text: "Heatmap",
+					},
+					Padding: Box{
+						Left:   10,
+						Top:    10,
+						Right:  10,
+						Bottom: 10,
+					},
+					SeriesList: seriesList,
+					XAxis: NewXAxisOption([]string{
+						"A",
+						"B",
+						"C",
+					}),
+					YAxisOptions: NewYAxisOptions([]string{
+						"Mon",
+						"Tue",
+					}),
+					VisualMap: VisualMapOption{
+						ItemHeight: 20,
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"10\" y=\"25\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Heatmap</text><text x=\"576\" y=\"187\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">8</text><text x=\"576\" y=\"232\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">1</text><path  d=\"M 570 192\nL 590 192\nL 590 194\nL 570 194\nL 570 192\" style=\"stroke-width:0;stroke:none;fill:rgba(191,68,76,1.0)\"/><path  d=\"M 570 194\nL 590 194\nL 590 196\nL 570 196\nL 570 194\" style=\"stroke-width:0;stroke:none;fill:rgba(196,80,84,1.0)\"/><path  d=\"M 570 196\nL 590 196\nL 590 198\nL 570 198\nL 570 196\" style=\"stroke-width:0;stroke:none;fill:rgba(201,93,92,1.0)\"/><path  d=\"M 570 198\nL 590 198\nL 590 200\nL 570 200\nL 570 198\" style=\"stroke-width:0;stroke:none;fill:rgba(206,105,99,1.0)\"/><path  d=\"M 570 200\nL 590 200\nL 590 202\nL 570 202\nL 570 200\" style=\"stroke-width:0;stroke:none;fill:rgba(211,118,107,1.0)\"/><path  d=\"M 570 202\nL 590 202\nL 590 204\nL 570 204\nL 570 202\" style=\"stroke-width:0;stroke:none;fill:rgba(216,130,115,1.0)\"/><path  d=\"M 570 204\nL 590 204\nL 590 206\nL 570 206\nL 570 204\" style=\"stroke-width:0;stroke:none;fill:rgba(222,152,125,1.0)\"/><path  d=\"M 570 206\nL 590 206\nL 590 208\nL 570 208\nL 570 206\" style=\"stroke-width:0;stroke:none;fill:rgba(228,174,135,1.0)\"/><path  d=\"M 570 208\nL 590 208\nL 590 210\nL 570 210\nL 570 208\" style=\"stroke-width:0;stroke:none;fill:rgba(234,195,146,1.0)\"/><path  d=\"M 570 210\nL 590 210\nL 590 212\nL 570 212\nL 570 210\" style=\"stroke-width:0;stroke:none;fill:rgba(240,217,156,1.0)\"/><path  d=\"M 46 45\nL 51 45\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 46 202\nL 51 202\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 46 360\nL 51 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 51 45\nL 51 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"14\" y=\"130\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Tue</text><text x=\"10\" y=\"288\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Mon</text><path  d=\"M 51 365\nL 51 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 220 365\nL 220 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 390 365\nL 390 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 560 365\nL 560 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 51 360\nL 560 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"130\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"300\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"470\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">C</text><path  d=\"M 51 202\nL 220 202\nL 220 360\nL 51 360\nL 51 202\" style=\"stroke-width:0;stroke:none;fill:rgba(246,239,166,1.0)\"/><text x=\"131\" y=\"287\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">1</text><path  d=\"M 220 202\nL 390 202\nL 390 360\nL 220 360\nL 220 202\" style=\"stroke-width:0;stroke:none;fill:rgba(212,121,109,1.0)\"/><text x=\"301\" y=\"287\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">5</text><path  d=\"M 390 202\nL 560 202\nL 560 360\nL 390 360\nL 390 202\" style=\"stroke-width:0;stroke:none;fill:rgba(229,177,137,1.0)\"/><text x=\"471\" y=\"287\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">3</text><path  d=\"M 51 45\nL 220 45\nL 220 202\nL 51 202\nL 51 45\" style=\"stroke-width:0;stroke:none;fill:rgba(191,68,76,1.0)\"/><text x=\"131\" y=\"129\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">8</text><path  d=\"M 390 45\nL 560 45\nL 560 202\nL 390 202\nL 390 45\" style=\"stroke-width:0;stroke:none;fill:rgba(237,208,151,1.0)\"/><text x=\"471\" y=\"129\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">2</text></svg>",
+		},
+	}
+
+	for _, tt := range tests {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		data, err := tt.render(p)
+		assert.Nil(err)
+		assert.Equal(tt.result, string(data))
+	}
+}
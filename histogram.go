@@ -0,0 +1,163 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/dustin/go-humanize"
+)
+
+type HistogramOption struct {
+	// The count of bins, it's used if the bin width is not set
+	BinCount int
+	// The width of bins, the bins are aligned to the multiple of width
+	BinWidth float64
+	// Show the cumulative percent line on the second y axis
+	Cumulative bool
+}
+
+type HistogramBin struct {
+	// The start of bin (inclusive)
+	Start float64
+	// The end of bin (exclusive, the last bin is inclusive)
+	End float64
+	// The count of samples in bin
+	Count int
+}
+
+// maxHistogramBinCount is the max count of bins, it avoids too many bins of large range
+const maxHistogramBinCount = 1000
+
+// NewHistogramBins returns the bins of samples. The bins are computed by fixed width
+// if BinWidth is set, otherwise by fixed count if BinCount is set, or the width is
+// computed by Freedman–Diaconis rule.
+func NewHistogramBins(samples []float64, opt HistogramOption) ([]HistogramBin, error) {
+	if len(samples) == 0 {
+		return nil, errors.New("The samples of histogram should not be empty")
+	}
+	values := make([]float64, len(samples))
+	copy(values, samples)
+	sort.Float64s(values)
+	min := values[0]
+	max := values[len(values)-1]
+
+	start := min
+	width := opt.BinWidth
+	count := 0
+	switch {
+	case width > 0:
+		start = math.Floor(min/width) * width
+	case opt.BinCount > 0:
+		count = opt.BinCount
+		width = (max - min) / float64(count)
+	default:
+		width = getFreedmanDiaconisWidth(values)
+		if width > 0 {
+			start = math.Floor(min/width) * width
+		}
+	}
+	// 所有样本相同
+	if width <= 0 {
+		width = 1
+		count = 1
+	}
+	if count == 0 {
+		count = int(math.Ceil((max-start)/width - niceEpsilon))
+		// 最大值在起始位置(仅一个值)
+		if count == 0 {
+			count = 1
+		}
+	}
+	if count > maxHistogramBinCount {
+		return nil, errors.New("The count of histogram bins should be <= 1000")
+	}
+
+	bins := make([]HistogramBin, count)
+	for i := range bins {
+		bins[i] = HistogramBin{
+			Start: start + float64(i)*width,
+			End:   start + float64(i+1)*width,
+		}
+	}
+	for _, v := range values {
+		index := int(math.Floor((v-start)/width + niceEpsilon))
+		if index >= count {
+			index = count - 1
+		}
+		bins[index].Count++
+	}
+	return bins, nil
+}
+
+// getFreedmanDiaconisWidth returns the nice bin width by Freedman–Diaconis rule,
+// it uses Sturges' formula if the IQR is 0
+func getFreedmanDiaconisWidth(sortedValues []float64) float64 {
+	n := float64(len(sortedValues))
+	span := sortedValues[len(sortedValues)-1] - sortedValues[0]
+	if span <= 0 {
+		return 0
+	}
+	iqr := quantile(sortedValues, 0.75) - quantile(sortedValues, 0.25)
+	width := 2 * iqr / math.Cbrt(n)
+	if width <= 0 {
+		width = span / (math.Ceil(math.Log2(n)) + 1)
+	}
+	return niceNumber(width)
+}
+
+// newHistogramSeriesList returns the series list and x axis data of histogram bins
+func newHistogramSeriesList(bins []HistogramBin, cumulative bool) (SeriesList, []string) {
+	counts := make([]float64, len(bins))
+	labels := make([]string, len(bins))
+	total := 0
+	// 按分组宽度的精度展示，保留两位有效数字
+	decimals := 0
+	if len(bins) != 0 {
+		width := bins[0].End - bins[0].Start
+		decimals = int(math.Max(0, 1-math.Floor(math.Log10(width))))
+	}
+	for index, bin := range bins {
+		counts[index] = float64(bin.Count)
+		labels[index] = humanize.FtoaWithDigits(bin.Start, decimals) + "~" + humanize.FtoaWithDigits(bin.End, decimals)
+		total += bin.Count
+	}
+	seriesList := SeriesList{
+		NewSeriesFromValues(counts, ChartTypeBar),
+	}
+	if !cumulative {
+		return seriesList, labels
+	}
+	percents := make([]float64, len(bins))
+	sum := 0
+	for index, bin := range bins {
+		sum += bin.Count
+		percents[index] = float64(sum) * 100 / float64(total)
+	}
+	series := NewSeriesFromValues(percents, ChartTypeLine)
+	series.AxisIndex = 1
+	seriesList = append(seriesList, series)
+	return seriesList, labels
+}
@@ -0,0 +1,164 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHistogramBins(t *testing.T) {
+	assert := assert.New(t)
+
+	samples := []float64{
+		1,
+		2,
+		2,
+		3,
+		3,
+		3,
+		4,
+		4,
+		5,
+		9,
+	}
+
+	// 指定宽度
+	bins, err := NewHistogramBins(samples, HistogramOption{
+		BinWidth: 2,
+	})
+	assert.Nil(err)
+	assert.Equal([]HistogramBin{
+		{
+			Start: 0,
+			End:   2,
+			Count: 1,
+		},
+		{
+			Start: 2,
+			End:   4,
+			Count: 5,
+		},
+		{
+			Start: 4,
+			End:   6,
+			Count: 3,
+		},
+		{
+			Start: 6,
+			End:   8,
+			Count: 0,
+		},
+		{
+			Start: 8,
+			End:   10,
+			Count: 1,
+		},
+	}, bins)
+
+	// 指定数量，最大值包含在最后一组
+	bins, err = NewHistogramBins(samples, HistogramOption{
+		BinCount: 4,
+	})
+	assert.Nil(err)
+	assert.Equal([]HistogramBin{
+		{
+			Start: 1,
+			End:   3,
+			Count: 3,
+		},
+		{
+			Start: 3,
+			End:   5,
+			Count: 5,
+		},
+		{
+			Start: 5,
+			End:   7,
+			Count: 1,
+		},
+		{
+			Start: 7,
+			End:   9,
+			Count: 1,
+		},
+	}, bins)
+
+	// Freedman–Diaconis
+	bins, err = NewHistogramBins(samples, HistogramOption{})
+	assert.Nil(err)
+	assert.Equal(5, len(bins))
+	assert.Equal(0.0, bins[0].Start)
+	assert.Equal(2.0, bins[0].End)
+	assert.Equal(10.0, bins[4].End)
+
+	// 所有值相同
+	bins, err = NewHistogramBins([]float64{
+		3,
+		3,
+	}, HistogramOption{})
+	assert.Nil(err)
+	assert.Equal([]HistogramBin{
+		{
+			Start: 3,
+			End:   4,
+			Count: 2,
+		},
+	}, bins)
+
+	_, err = NewHistogramBins(nil, HistogramOption{})
+	assert.Equal("The samples of histogram should not be empty", err.Error())
+
+	_, err = NewHistogramBins(samples, HistogramOption{
+		BinWidth: 0.001,
+	})
+	assert.Equal("The count of histogram bins should be <= 1000", err.Error())
+}
+
+func TestNewHistogramSeriesList(t *testing.T) {
+	assert := assert.New(t)
+
+	seriesList, labels := newHistogramSeriesList([]HistogramBin{
+		{
+			Start: 0,
+			End:   0.5,
+			Count: 1,
+		},
+		{
+			Start: 0.5,
+			End:   1,
+			Count: 3,
+		},
+	}, true)
+	assert.Equal([]string{
+		"0~0.5",
+		"0.5~1",
+	}, labels)
+	assert.Equal(2, len(seriesList))
+	assert.Equal(ChartTypeBar, seriesList[0].Type)
+	assert.Equal(ChartTypeLine, seriesList[1].Type)
+	assert.Equal(1, seriesList[1].AxisIndex)
+	assert.Equal(25.0, seriesList[1].Data[0].Value)
+	assert.Equal(100.0, seriesList[1].Data[1].Value)
+}
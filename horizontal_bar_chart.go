@@ -81,7 +81,9 @@ func (h *horizontalBarChart) render(result *defaultRenderResult, seriesList Seri
 		margin = 5
 		barMargin = 3
 	}
-	seriesCount := len(seriesList)
+	// 同一堆叠的柱状图共用位置
+	stackIndexes, seriesCount := seriesList.getStackIndexes()
+	stackValues := seriesList.getStackValues()
 	// 总的高度-两个margin-(总数-1)的barMargin
 	barHeight := (height - 2*margin - barMargin*(seriesCount-1)) / seriesCount
 	if opt.BarHeight > 0 && opt.BarHeight < barHeight {
@@ -91,14 +93,7 @@ func (h *horizontalBarChart) render(result *defaultRenderResult, seriesList Seri
 
 	theme := opt.Theme
 
-	max, min := seriesList.GetMaxMin(0)
-	xRange := NewRange(AxisRangeOption{
-		Painter:     p,
-		Min:         min,
-		Max:         max,
-		DivideCount: defaultAxisDivideCount,
-		Size:        seriesPainter.Width(),
-	})
+	xRange := result.xAxisRange
 	seriesNames := seriesList.Names()
 
 	rendererList := []Renderer{}
@@ -126,21 +121,34 @@ func (h *horizontalBarChart) render(result *defaultRenderResult, seriesList Seri
 			j = yRange.divideCount - j - 1
 			y := divideValues[j]
 			y += margin
-			if index != 0 {
-				y += index * (barHeight + barMargin)
+			if stackIndexes[index] != 0 {
+				y += stackIndexes[index] * (barHeight + barMargin)
 			}
 
-			w := int(xRange.getHeight(item.Value))
+			// 数据的索引
+			dataIndex := yRange.divideCount - j - 1
+			value := stackValues.values[index][dataIndex]
+			baseValue := stackValues.baseValues[index][dataIndex]
+			w := int(xRange.getHeight(value + baseValue))
 			fillColor := seriesColor
 			if !item.Style.FillColor.IsZero() {
 				fillColor = item.Style.FillColor
 			}
+			left := 0
 			right := w
+			// 堆叠的柱状图从前一系列的末端开始
+			if baseValue != 0 {
+				left = int(xRange.getHeight(baseValue))
+				// 负值向左堆叠
+				if left > right {
+					left, right = right, left
+				}
+			}
 			seriesPainter.OverrideDrawingStyle(Style{
 				FillColor: fillColor,
 			}).Rect(chart.Box{
 				Top:    y,
-				Left:   0,
+				Left:   left,
 				Right:  right,
 				Bottom: y + barHeight,
 			})
@@ -157,9 +165,10 @@ func (h *horizontalBarChart) render(result *defaultRenderResult, seriesList Seri
 				Offset:    series.Label.Offset,
 				FontColor: series.Label.Color,
 				FontSize:  series.Label.FontSize,
+				Percent:   stackValues.getPercent(index, dataIndex),
 			}
 			if series.Label.Position == PositionLeft {
-				labelValue.X = 0
+				labelValue.X = left
 				if labelValue.FontColor.IsZero() {
 					if isLightColor(fillColor) {
 						labelValue.FontColor = defaultLightFontColor
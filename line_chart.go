@@ -84,18 +84,24 @@ func (l *lineChart) render(result *defaultRenderResult, seriesList SeriesList) (
 
 	seriesPainter := result.seriesPainter
 
-	xDivideCount := len(opt.XAxis.Data)
-	if !boundaryGap {
-		xDivideCount--
-	}
-	xDivideValues := autoDivide(seriesPainter.Width(), xDivideCount)
-	xValues := make([]int, len(xDivideValues)-1)
-	if boundaryGap {
-		for i := 0; i < len(xDivideValues)-1; i++ {
-			xValues[i] = (xDivideValues[i] + xDivideValues[i+1]) >> 1
-		}
-	} else {
-		xValues = xDivideValues
+	isTimeAxis := opt.XAxis.Type == AxisTypeTime
+	xRange := result.xAxisRange
+	xValues := make([]int, 0)
+	// 时间轴按时间计算位置，无需划分
+	if !isTimeAxis {
+		xDivideCount := len(opt.XAxis.Data)
+		if !boundaryGap {
+			xDivideCount--
+		}
+		xDivideValues := autoDivide(seriesPainter.Width(), xDivideCount)
+		xValues = make([]int, len(xDivideValues)-1)
+		if boundaryGap {
+			for i := 0; i < len(xDivideValues)-1; i++ {
+				xValues[i] = (xDivideValues[i] + xDivideValues[i+1]) >> 1
+			}
+		} else {
+			xValues = xDivideValues
+		}
 	}
 	markPointPainter := NewMarkPointPainter(seriesPainter)
 	markLinePainter := NewMarkLinePainter(seriesPainter)
@@ -108,6 +114,9 @@ func (l *lineChart) render(result *defaultRenderResult, seriesList SeriesList) (
 		strokeWidth = defaultStrokeWidth
 	}
 	seriesNames := seriesList.Names()
+	stackValues := seriesList.getStackValues()
+	// 已绘制的堆叠分组
+	stackKeys := make(map[string]bool)
 	for index := range seriesList {
 		series := seriesList[index]
 		seriesColor := opt.Theme.GetSeriesColor(series.index)
@@ -132,16 +141,44 @@ func (l *lineChart) render(result *defaultRenderResult, seriesList SeriesList) (
 			})
 			rendererList = append(rendererList, labelPainter)
 		}
+		// 堆叠时区域填充至前一系列
+		basePoints := make([]Point, 0)
+		// 置信区间的上下边界
+		upperPoints := make([]Point, 0)
+		lowerPoints := make([]Point, 0)
 		for i, item := range series.Data {
-			h := yRange.getRestHeight(item.Value)
+			value := stackValues.values[index][i]
+			baseValue := stackValues.baseValues[index][i]
+			h := yRange.getRestHeight(value + baseValue)
 			if item.Value == nullValue {
 				h = int(math.MaxInt32)
 			}
+			var x int
+			if isTimeAxis {
+				x = xRange.getHeight(timeToValue(item.Time))
+			} else {
+				x = xValues[i]
+			}
 			p := Point{
-				X: xValues[i],
+				X: x,
 				Y: h,
 			}
 			points = append(points, p)
+			basePoints = append(basePoints, Point{
+				X: x,
+				Y: yRange.getRestHeight(baseValue),
+			})
+			upper := Point{
+				X: x,
+				Y: int(math.MaxInt32),
+			}
+			lower := upper
+			if lowerValue, upperValue, ok := getSeriesDataBounds(item); ok && !stackValues.percents[index] {
+				upper.Y = yRange.getRestHeight(upperValue + baseValue)
+				lower.Y = yRange.getRestHeight(lowerValue + baseValue)
+			}
+			upperPoints = append(upperPoints, upper)
+			lowerPoints = append(lowerPoints, lower)
 
 			// 如果label不需要展示，则返回
 			if labelPainter == nil {
@@ -154,24 +191,54 @@ func (l *lineChart) render(result *defaultRenderResult, seriesList SeriesList) (
 				Y:     p.Y,
 				// 字体大小
 				FontSize: series.Label.FontSize,
+				Percent:  stackValues.getPercent(index, i),
 			})
 		}
+		isStacked := false
+		if series.Stack != "" {
+			key := series.stackKey()
+			isStacked = stackKeys[key]
+			stackKeys[key] = true
+		}
+		// 阶梯线使用转换后的点绘制，标签与标记点仍使用原始的点
+		linePoints := points
+		if series.Step != "" {
+			linePoints = getStepPoints(points, series.Step)
+			basePoints = getStepPoints(basePoints, series.Step)
+		}
+		// 置信区间
+		if series.Step != "" {
+			upperPoints = getStepPoints(upperPoints, series.Step)
+			lowerPoints = getStepPoints(lowerPoints, series.Step)
+		}
+		for _, bandPoints := range getBandAreas(upperPoints, lowerPoints) {
+			seriesPainter.SetDrawingStyle(Style{
+				FillColor: seriesColor.WithAlpha(defaultBandOpacity),
+			})
+			seriesPainter.FillArea(bandPoints)
+		}
 		// 如果需要填充区域
 		if opt.FillArea {
-			areaPoints := make([]Point, len(points))
-			copy(areaPoints, points)
+			areaPoints := make([]Point, len(linePoints))
+			copy(areaPoints, linePoints)
 			bottomY := yRange.getRestHeight(yRange.min)
 			var opacity uint8 = 200
 			if opt.Opacity != 0 {
 				opacity = opt.Opacity
 			}
-			areaPoints = append(areaPoints, Point{
-				X: areaPoints[len(areaPoints)-1].X,
-				Y: bottomY,
-			}, Point{
-				X: areaPoints[0].X,
-				Y: bottomY,
-			}, areaPoints[0])
+			if isStacked {
+				reversePointSlice(basePoints)
+				areaPoints = append(areaPoints, basePoints...)
+				areaPoints = append(areaPoints, areaPoints[0])
+			} else {
+				areaPoints = append(areaPoints, Point{
+					X: areaPoints[len(areaPoints)-1].X,
+					Y: bottomY,
+				}, Point{
+					X: areaPoints[0].X,
+					Y: bottomY,
+				}, areaPoints[0])
+			}
 			seriesPainter.SetDrawingStyle(Style{
 				FillColor: seriesColor.WithAlpha(opacity),
 			})
@@ -180,7 +247,7 @@ func (l *lineChart) render(result *defaultRenderResult, seriesList SeriesList) (
 		seriesPainter.SetDrawingStyle(drawingStyle)
 
 		// 画线
-		seriesPainter.LineStroke(points)
+		seriesPainter.LineStroke(linePoints)
 
 		// 画点
 		if opt.Theme.IsDark() {
@@ -217,6 +284,68 @@ func (l *lineChart) render(result *defaultRenderResult, seriesList SeriesList) (
 	return p.box, nil
 }
 
+const defaultBandOpacity uint8 = 60
+
+// getBandAreas returns the areas between upper and lower points,
+// the null points break the band into several areas
+func getBandAreas(upperPoints, lowerPoints []Point) [][]Point {
+	areas := make([][]Point, 0)
+	start := 0
+	for index := 0; index <= len(upperPoints); index++ {
+		if index < len(upperPoints) &&
+			upperPoints[index].Y != int(math.MaxInt32) &&
+			lowerPoints[index].Y != int(math.MaxInt32) {
+			continue
+		}
+		if index-start >= 2 {
+			area := append([]Point{}, upperPoints[start:index]...)
+			for i := index - 1; i >= start; i-- {
+				area = append(area, lowerPoints[i])
+			}
+			area = append(area, upperPoints[start])
+			areas = append(areas, area)
+		}
+		start = index + 1
+	}
+	return areas
+}
+
+// getStepPoints returns the points of step line, the vertical segment is at the start,
+// middle or end of two points. The null points are kept to break the line.
+func getStepPoints(points []Point, step string) []Point {
+	result := make([]Point, 0, 3*len(points))
+	for index, point := range points {
+		if index == 0 || point.Y == int(math.MaxInt32) || points[index-1].Y == int(math.MaxInt32) {
+			result = append(result, point)
+			continue
+		}
+		prev := points[index-1]
+		switch step {
+		case StepStart:
+			result = append(result, Point{
+				X: prev.X,
+				Y: point.Y,
+			})
+		case StepMiddle:
+			middle := (prev.X + point.X) >> 1
+			result = append(result, Point{
+				X: middle,
+				Y: prev.Y,
+			}, Point{
+				X: middle,
+				Y: point.Y,
+			})
+		case StepEnd:
+			result = append(result, Point{
+				X: point.X,
+				Y: prev.Y,
+			})
+		}
+		result = append(result, point)
+	}
+	return result
+}
+
 func (l *lineChart) Render() (Box, error) {
 	p := l.p
 	opt := l.opt
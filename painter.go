@@ -65,6 +65,8 @@ type TicksOption struct {
 	Orient string
 	Count  int
 	Unit   int
+	// The positions of ticks, the ticks are divided equally if it's empty
+	Positions []int
 }
 
 type MultiTextOption struct {
@@ -78,6 +80,8 @@ type MultiTextOption struct {
 	Offset       Box
 	// The first text index
 	First int
+	// The positions of texts, the texts are divided equally if it's empty
+	Positions []int
 }
 
 type GridOption struct {
@@ -629,7 +633,9 @@ func (p *Painter) Ticks(opt TicksOption) *Painter {
 	}
 	var values []int
 	isVertical := opt.Orient == OrientVertical
-	if isVertical {
+	if len(opt.Positions) != 0 {
+		values = opt.Positions
+	} else if isVertical {
 		values = autoDivide(height, count)
 	} else {
 		values = autoDivide(width, count)
@@ -678,7 +684,7 @@ func (p *Painter) MultiText(opt MultiTextOption) *Painter {
 	if containsString([]string{
 		PositionLeft,
 		PositionTop,
-	}, opt.Position) {
+	}, opt.Position) || len(opt.Positions) != 0 {
 		positionCenter = false
 		count--
 		// 非居中
@@ -688,7 +694,9 @@ func (p *Painter) MultiText(opt MultiTextOption) *Painter {
 	height := p.Height()
 	var values []int
 	isVertical := opt.Orient == OrientVertical
-	if isVertical {
+	if len(opt.Positions) != 0 {
+		values = opt.Positions
+	} else if isVertical {
 		values = autoDivide(height, count)
 	} else {
 		values = autoDivide(width, count)
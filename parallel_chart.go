@@ -0,0 +1,360 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"errors"
+	"math"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+type parallelChart struct {
+	p   *Painter
+	opt *ParallelChartOption
+}
+
+type ParallelAxis struct {
+	// The name of axis
+	Name string
+	// The maximum value of axis
+	Max float64
+	// The minimum value of axis.
+	// The range is calculated from the values of dimension if max is not greater than min
+	Min float64
+}
+
+type ParallelChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The data series list, each series is a category of records
+	SeriesList SeriesList
+	// The padding of parallel chart
+	Padding Box
+	// The option of title
+	Title TitleOption
+	// The legend option
+	Legend LegendOption
+	// The axis list, each dimension of record has an axis
+	ParallelAxes []ParallelAxis
+	// The visual map option, the records are colored by the value of dimension if its dimension is set
+	VisualMap VisualMapOption
+	// The stroke width of line, default is 1
+	StrokeWidth float64
+	// The (alpha) opacity of line, default is 180
+	Opacity uint8
+	// background is filled
+	backgroundIsFilled bool
+}
+
+const defaultParallelStrokeWidth = 1.0
+const defaultParallelOpacity uint8 = 180
+const defaultParallelDivideCount = 5
+
+// ParallelData is the data of parallel chart
+type ParallelData struct {
+	// The values of each dimension of record
+	Values []float64
+}
+
+// parallelValues returns the values of each dimension of parallel record
+func (item *SeriesData) parallelValues() []float64 {
+	if item.Parallel == nil {
+		return nil
+	}
+	return item.Parallel.Values
+}
+
+// NewParallelSeriesList returns a series list for parallel chart,
+// values[i][j] is the values of each dimension of the j-th record in the i-th category
+func NewParallelSeriesList(values [][][]float64) SeriesList {
+	seriesList := make(SeriesList, len(values))
+	for index, records := range values {
+		data := make([]SeriesData, len(records))
+		for i, record := range records {
+			data[i] = SeriesData{
+				Parallel: &ParallelData{
+					Values: record,
+				},
+			}
+		}
+		seriesList[index] = Series{
+			Type: ChartTypeParallel,
+			Data: data,
+		}
+	}
+	return seriesList
+}
+
+// NewParallelAxes returns a parallel axis list, the range of axis is calculated from the values of dimension
+func NewParallelAxes(names []string) []ParallelAxis {
+	axes := make([]ParallelAxis, len(names))
+	for index, name := range names {
+		axes[index] = ParallelAxis{
+			Name: name,
+		}
+	}
+	return axes
+}
+
+// NewParallelChart returns a parallel chart renderer
+func NewParallelChart(p *Painter, opt ParallelChartOption) *parallelChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &parallelChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// getParallelDimensionCount returns the count of dimension,
+// it's the max of axis count and value count of records
+func getParallelDimensionCount(axes []ParallelAxis, seriesList SeriesList) int {
+	count := len(axes)
+	for _, series := range seriesList {
+		for _, item := range series.Data {
+			count = chart.MaxInt(count, len(item.parallelValues()))
+		}
+	}
+	return count
+}
+
+// getParallelMaxMin returns the max and min value of dimension, the null values are ignored
+func getParallelMaxMin(seriesList SeriesList, dimension int) (float64, float64) {
+	max := -math.MaxFloat64
+	min := math.MaxFloat64
+	for _, series := range seriesList {
+		for _, item := range series.Data {
+			values := item.parallelValues()
+			if dimension >= len(values) || values[dimension] == nullValue {
+				continue
+			}
+			max = math.Max(max, values[dimension])
+			min = math.Min(min, values[dimension])
+		}
+	}
+	if max < min {
+		return 0, 0
+	}
+	return max, min
+}
+
+// parallelVisualMap returns the visual map of parallel chart,
+// it's nil if the dimension of visual map is not set
+func parallelVisualMap(visualMap VisualMapOption, seriesList SeriesList) *VisualMapOption {
+	if visualMap.Dimension == nil {
+		return nil
+	}
+	max, min := getParallelMaxMin(seriesList, *visualMap.Dimension)
+	if visualMap.Min == nil {
+		visualMap.Min = &min
+	}
+	if visualMap.Max == nil {
+		visualMap.Max = &max
+	}
+	if len(visualMap.Colors) == 0 {
+		visualMap.Colors = defaultVisualMapColors
+	}
+	return &visualMap
+}
+
+func (pc *parallelChart) render(result *defaultRenderResult, seriesList SeriesList) (Box, error) {
+	opt := pc.opt
+	axes := opt.ParallelAxes
+	count := getParallelDimensionCount(axes, seriesList)
+	if count < 2 {
+		return BoxZero, errors.New("The count of parallel axis should be >= 2")
+	}
+	theme := opt.Theme
+	seriesPainter := result.seriesPainter
+	seriesPainter.OverrideTextStyle(Style{
+		FontColor: theme.GetTextColor(),
+		FontSize:  labelFontSize,
+		Font:      opt.Font,
+	})
+
+	// 轴名称的宽度不超过轴的间隔
+	maxNameWidth := seriesPainter.Width() / count
+	names := make([]string, count)
+	hasName := false
+	for index := range names {
+		if index < len(axes) && axes[index].Name != "" {
+			names[index] = truncateText(seriesPainter, axes[index].Name, maxNameWidth)
+			hasName = true
+		}
+	}
+	textHeight := seriesPainter.MeasureText("0").Height()
+	nameMargin := 10
+	top := textHeight >> 1
+	if hasName {
+		top += textHeight + nameMargin
+	}
+	height := seriesPainter.Height() - top - textHeight>>1
+
+	ranges := make([]axisRange, count)
+	for index := range ranges {
+		max, min := getParallelMaxMin(seriesList, index)
+		rangeOpt := AxisRangeOption{
+			Painter:     seriesPainter,
+			Min:         min,
+			Max:         max,
+			Size:        height,
+			DivideCount: defaultParallelDivideCount,
+		}
+		if index < len(axes) && axes[index].Max > axes[index].Min {
+			rangeOpt.FixedMin = &axes[index].Min
+			rangeOpt.FixedMax = &axes[index].Max
+		}
+		ranges[index] = NewRange(rangeOpt)
+	}
+
+	// 刻度值展示在轴的左侧，第一个轴预留刻度值的宽度
+	tickLength := 4
+	labelMargin := 2
+	firstLabelWidth, _ := seriesPainter.MeasureTextMaxWidthHeight(ranges[0].Values())
+	left := chart.MaxInt(firstLabelWidth+tickLength+labelMargin, seriesPainter.MeasureText(names[0]).Width()>>1)
+	right := seriesPainter.MeasureText(names[count-1]).Width() >> 1
+	xValues := make([]int, count)
+	spacing := float64(seriesPainter.Width()-left-right) / float64(count-1)
+	for index := range xValues {
+		xValues[index] = left + int(math.Round(float64(index)*spacing))
+	}
+
+	strokeWidth := opt.StrokeWidth
+	if strokeWidth <= 0 {
+		strokeWidth = defaultParallelStrokeWidth
+	}
+	opacity := opt.Opacity
+	if opacity == 0 {
+		opacity = defaultParallelOpacity
+	}
+	visualMap := parallelVisualMap(opt.VisualMap, seriesList)
+	for _, series := range seriesList {
+		seriesColor := theme.GetSeriesColor(series.index)
+		for _, item := range series.Data {
+			values := item.parallelValues()
+			points := make([]Point, count)
+			for index := range points {
+				points[index] = Point{
+					X: xValues[index],
+					Y: int(math.MaxInt32),
+				}
+				if index >= len(values) || values[index] == nullValue {
+					continue
+				}
+				r := ranges[index]
+				// 超出范围的值展示在轴的两端
+				value := math.Max(math.Min(values[index], r.max), r.min)
+				points[index].Y = top + r.getRestHeight(value)
+			}
+			color := seriesColor
+			if visualMap != nil {
+				dimension := *visualMap.Dimension
+				if dimension < len(values) && values[dimension] != nullValue {
+					color = visualMap.GetColor(values[dimension])
+				}
+			}
+			seriesPainter.OverrideDrawingStyle(Style{
+				StrokeColor: color.WithAlpha(opacity),
+				StrokeWidth: strokeWidth,
+			}).LineStroke(points)
+		}
+	}
+
+	// 轴在折线之后绘制，避免被覆盖
+	seriesPainter.OverrideDrawingStyle(Style{
+		StrokeColor: theme.GetAxisStrokeColor(),
+		StrokeWidth: 1,
+	})
+	for index, x := range xValues {
+		seriesPainter.LineStroke([]Point{
+			{
+				X: x,
+				Y: top,
+			},
+			{
+				X: x,
+				Y: top + height,
+			},
+		})
+		r := ranges[index]
+		for i, value := range r.Values() {
+			y := top + height - r.getHeight(r.min+(r.max-r.min)*float64(i)/float64(r.divideCount))
+			seriesPainter.LineStroke([]Point{
+				{
+					X: x - tickLength,
+					Y: y,
+				},
+				{
+					X: x,
+					Y: y,
+				},
+			})
+			b := seriesPainter.MeasureText(value)
+			seriesPainter.Text(value, x-tickLength-labelMargin-b.Width(), y+textHeight>>1)
+		}
+		if names[index] != "" {
+			b := seriesPainter.MeasureText(names[index])
+			seriesPainter.Text(names[index], x-b.Width()>>1, textHeight)
+		}
+	}
+
+	return pc.p.box, nil
+}
+
+func (pc *parallelChart) Render() (Box, error) {
+	p := pc.p
+	opt := pc.opt
+	legend := opt.Legend
+	visualMap := parallelVisualMap(opt.VisualMap, opt.SeriesList)
+	// 按维度着色时，使用visual map代替图例
+	if visualMap != nil {
+		legend.Show = FalseFlag()
+	}
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		Theme:      opt.Theme,
+		Padding:    opt.Padding,
+		SeriesList: opt.SeriesList,
+		XAxis: XAxisOption{
+			Show: FalseFlag(),
+		},
+		YAxisOptions: []YAxisOption{
+			{
+				Show: FalseFlag(),
+			},
+		},
+		TitleOption:        opt.Title,
+		LegendOption:       legend,
+		VisualMapOption:    visualMap,
+		backgroundIsFilled: opt.backgroundIsFilled,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	seriesList := opt.SeriesList.Filter(ChartTypeParallel)
+	return pc.render(renderResult, seriesList)
+}
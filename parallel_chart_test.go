@@ -0,0 +1,212 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewParallelSeriesList(t *testing.T) {
+	assert := assert.New(t)
+
+	seriesList := NewParallelSeriesList([][][]float64{
+		{
+			{
+				120,
+				45,
+			},
+			{
+				135,
+				50,
+				512,
+			},
+		},
+		{
+			{
+				80,
+				nullValue,
+				820,
+			},
+		},
+	})
+	assert.Equal(2, len(seriesList))
+	assert.Equal(ChartTypeParallel, seriesList[0].Type)
+	assert.Equal(2, len(seriesList[0].Data))
+	assert.Equal([]float64{
+		135,
+		50,
+		512,
+	}, seriesList[0].Data[1].Parallel.Values)
+
+	assert.Equal(3, getParallelDimensionCount(nil, seriesList))
+	assert.Equal(4, getParallelDimensionCount(NewParallelAxes([]string{
+		"Latency",
+		"CPU",
+		"Memory",
+		"Cost",
+	}), seriesList))
+
+	max, min := getParallelMaxMin(seriesList, 1)
+	assert.Equal(50.0, max)
+	assert.Equal(45.0, min)
+	max, min = getParallelMaxMin(seriesList, 3)
+	assert.Equal(0.0, max)
+	assert.Equal(0.0, min)
+}
+
+func TestParallelVisualMap(t *testing.T) {
+	assert := assert.New(t)
+
+	seriesList := NewParallelSeriesList([][][]float64{
+		{
+			{
+				120,
+				45,
+			},
+			{
+				80,
+				50,
+			},
+		},
+	})
+	assert.Nil(parallelVisualMap(VisualMapOption{}, seriesList))
+
+	dimension := 0
+	max := 200.0
+	visualMap := parallelVisualMap(VisualMapOption{
+		Dimension: &dimension,
+		Max:       &max,
+	}, seriesList)
+	assert.Equal(80.0, *visualMap.Min)
+	assert.Equal(200.0, *visualMap.Max)
+	assert.Equal(defaultVisualMapColors, visualMap.Colors)
+}
+
+func TestParallelChart(t *testing.T) {
+	assert := assert.New(t)
+
+	values := [][][]float64{
+		{
+			{
+				120,
+				45,
+				512,
+				0.8,
+			},
+			{
+				135,
+				50,
+				498,
+				0.9,
+			},
+		},
+		{
+			{
+				80,
+				70,
+				820,
+				1.4,
+			},
+			{
+				85,
+				nullValue,
+				790,
+				1.5,
+			},
+		},
+	}
+	axes := []ParallelAxis{
+		{
+			Name: "Latency",
+		},
+		{
+			Name: "CPU",
+			Max:  100,
+		},
+		{
+			Name: "Memory",
+		},
+		{
+			Name: "Cost",
+		},
+	}
+	tests := []struct {
+		render func(*Painter) ([]byte, error)
+		result string
+	}{
+		{
+			render: func(p *Painter) ([]byte, error) {
+				seriesList := NewParallelSeriesList(values)
+				_, err := NewParallelChart(p, ParallelChartOption{
+					Title: TitleOption{
+						Text: "Benchmark",
+					},
+					Legend: NewLegendOption([]string{
+						"v1",
+						"v2",
+					}, PositionRight),
+					SeriesList:   seriesList,
+					ParallelAxes: axes,
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 484 9\nL 514 9\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><circle cx=\"499\" cy=\"9\" r=\"5\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"516\" y=\"15\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">v1</text><path  d=\"M 553 9\nL 583 9\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><circle cx=\"568\" cy=\"9\" r=\"5\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"585\" y=\"15\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">v2</text><text x=\"0\" y=\"15\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Benchmark</text><path  d=\"M 28 164\nL 214 229\nL 401 297\nL 587 364\" style=\"stroke-width:1;stroke:rgba(84,112,198,0.7);fill:none\"/><path  d=\"M 28 89\nL 214 214\nL 401 306\nL 587 327\" style=\"stroke-width:1;stroke:rgba(84,112,198,0.7);fill:none\"/><path  d=\"M 28 364\nL 214 154\nL 401 112\nL 587 139\" style=\"stroke-width:1;stroke:rgba(145,204,117,0.7);fill:none\"/><path  d=\"M 28 339\" style=\"stroke-width:1;stroke:rgba(145,204,117,0.7);fill:none\"/><path  d=\"M 401 130\nL 587 101\" style=\"stroke-width:1;stroke:rgba(145,204,117,0.7);fill:none\"/><path  d=\"M 28 63\nL 28 364\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 24 364\nL 28 364\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"7\" y=\"370\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text><path  d=\"M 24 314\nL 28 314\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"7\" y=\"320\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">90</text><path  d=\"M 24 264\nL 28 264\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"0\" y=\"270\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">100</text><path  d=\"M 24 214\nL 28 214\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"0\" y=\"220\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">110</text><path  d=\"M 24 164\nL 28 164\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"0\" y=\"170\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">120</text><path  d=\"M 24 114\nL 28 114\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"0\" y=\"120\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">130</text><path  d=\"M 24 63\nL 28 63\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"0\" y=\"69\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">140</text><text x=\"6\" y=\"47\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Latency</text><path  d=\"M 214 63\nL 214 364\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 210 364\nL 214 364\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"200\" y=\"370\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">0</text><path  d=\"M 210 304\nL 214 304\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"193\" y=\"310\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><path  d=\"M 210 244\nL 214 244\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"193\" y=\"250\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40</text><path  d=\"M 210 184\nL 214 184\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"193\" y=\"190\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">60</text><path  d=\"M 210 124\nL 214 124\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"193\" y=\"130\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text><path  d=\"M 210 63\nL 214 63\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"186\" y=\"69\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">100</text><text x=\"202\" y=\"47\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">CPU</text><path  d=\"M 401 63\nL 401 364\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 397 364\nL 401 364\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"373\" y=\"370\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">400</text><path  d=\"M 397 304\nL 401 304\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"373\" y=\"310\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">500</text><path  d=\"M 397 244\nL 401 244\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"373\" y=\"250\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">600</text><path  d=\"M 397 184\nL 401 184\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"373\" y=\"190\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">700</text><path  d=\"M 397 124\nL 401 124\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"373\" y=\"130\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">800</text><path  d=\"M 397 63\nL 401 63\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"373\" y=\"69\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">900</text><text x=\"377\" y=\"47\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Memory</text><path  d=\"M 587 63\nL 587 364\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 583 364\nL 587 364\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"562\" y=\"370\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">0.8</text><path  d=\"M 583 289\nL 587 289\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"562\" y=\"295\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">1.0</text><path  d=\"M 583 214\nL 587 214\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"562\" y=\"220\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">1.2</text><path  d=\"M 583 139\nL 587 139\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"562\" y=\"145\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">1.4</text><path  d=\"M 583 63\nL 587 63\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"562\" y=\"69\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">1.6</text><text x=\"574\" y=\"47\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Cost</text></svg>",
+		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				dimension := 0
+				_, err := NewParallelChart(p, ParallelChartOption{
+					SeriesList:   NewParallelSeriesList(values),
+					ParallelAxes: axes,
+					VisualMap: VisualMapOption{
+						Dimension: &dimension,
+					},
+					StrokeWidth: 2,
+					Opacity:     255,
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"574\" y=\"110\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">135</text><text x=\"578\" y=\"275\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">80</text><path  d=\"M 576 115\nL 596 115\nL 596 117\nL 576 117\nL 576 115\" style=\"stroke-width:0;stroke:none;fill:rgba(191,68,76,1.0)\"/><path  d=\"M 576 117\nL 596 117\nL 596 119\nL 576 119\nL 576 117\" style=\"stroke-width:0;stroke:none;fill:rgba(192,70,77,1.0)\"/><path  d=\"M 576 119\nL 596 119\nL 596 121\nL 576 121\nL 576 119\" style=\"stroke-width:0;stroke:none;fill:rgba(192,72,78,1.0)\"/><path  d=\"M 576 121\nL 596 121\nL 596 123\nL 576 123\nL 576 121\" style=\"stroke-width:0;stroke:none;fill:rgba(193,73,79,1.0)\"/><path  d=\"M 576 123\nL 596 123\nL 596 125\nL 576 125\nL 576 123\" style=\"stroke-width:0;stroke:none;fill:rgba(194,75,80,1.0)\"/><path  d=\"M 576 125\nL 596 125\nL 596 127\nL 576 127\nL 576 125\" style=\"stroke-width:0;stroke:none;fill:rgba(195,77,82,1.0)\"/><path  d=\"M 576 127\nL 596 127\nL 596 129\nL 576 129\nL 576 127\" style=\"stroke-width:0;stroke:none;fill:rgba(195,79,83,1.0)\"/><path  d=\"M 576 129\nL 596 129\nL 596 131\nL 576 131\nL 576 129\" style=\"stroke-width:0;stroke:none;fill:rgba(196,80,84,1.0)\"/><path  d=\"M 576 131\nL 596 131\nL 596 133\nL 576 133\nL 576 131\" style=\"stroke-width:0;stroke:none;fill:rgba(197,82,85,1.0)\"/><path  d=\"M 576 133\nL 596 133\nL 596 135\nL 576 135\nL 576 133\" style=\"stroke-width:0;stroke:none;fill:rgba(197,84,86,1.0)\"/><path  d=\"M 576 135\nL 596 135\nL 596 137\nL 576 137\nL 576 135\" style=\"stroke-width:0;stroke:none;fill:rgba(198,86,87,1.0)\"/><path  d=\"M 576 137\nL 596 137\nL 596 139\nL 576 139\nL 576 137\" style=\"stroke-width:0;stroke:none;fill:rgba(199,87,88,1.0)\"/><path  d=\"M 576 139\nL 596 139\nL 596 141\nL 576 141\nL 576 139\" style=\"stroke-width:0;stroke:none;fill:rgba(200,89,89,1.0)\"/><path  d=\"M 576 141\nL 596 141\nL 596 143\nL 576 143\nL 576 141\" style=\"stroke-width:0;stroke:none;fill:rgba(200,91,90,1.0)\"/><path  d=\"M 576 143\nL 596 143\nL 596 145\nL 576 145\nL 576 143\" style=\"stroke-width:0;stroke:none;fill:rgba(201,93,92,1.0)\"/><path  d=\"M 576 145\nL 596 145\nL 596 147\nL 576 147\nL 576 145\" style=\"stroke-width:0;stroke:none;fill:rgba(202,95,93,1.0)\"/><path  d=\"M 576 147\nL 596 147\nL 596 149\nL 576 149\nL 576 147\" style=\"stroke-width:0;stroke:none;fill:rgba(202,96,94,1.0)\"/><path  d=\"M 576 149\nL 596 149\nL 596 151\nL 576 151\nL 576 149\" style=\"stroke-width:0;stroke:none;fill:rgba(203,98,95,1.0)\"/><path  d=\"M 576 151\nL 596 151\nL 596 153\nL 576 153\nL 576 151\" style=\"stroke-width:0;stroke:none;fill:rgba(204,100,96,1.0)\"/><path  d=\"M 576 153\nL 596 153\nL 596 155\nL 576 155\nL 576 153\" style=\"stroke-width:0;stroke:none;fill:rgba(205,102,97,1.0)\"/><path  d=\"M 576 155\nL 596 155\nL 596 157\nL 576 157\nL 576 155\" style=\"stroke-width:0;stroke:none;fill:rgba(205,103,98,1.0)\"/><path  d=\"M 576 157\nL 596 157\nL 596 159\nL 576 159\nL 576 157\" style=\"stroke-width:0;stroke:none;fill:rgba(206,105,99,1.0)\"/><path  d=\"M 576 159\nL 596 159\nL 596 161\nL 576 161\nL 576 159\" style=\"stroke-width:0;stroke:none;fill:rgba(207,107,101,1.0)\"/><path  d=\"M 576 161\nL 596 161\nL 596 163\nL 576 163\nL 576 161\" style=\"stroke-width:0;stroke:none;fill:rgba(207,109,102,1.0)\"/><path  d=\"M 576 163\nL 596 163\nL 596 165\nL 576 165\nL 576 163\" style=\"stroke-width:0;stroke:none;fill:rgba(208,111,103,1.0)\"/><path  d=\"M 576 165\nL 596 165\nL 596 167\nL 576 167\nL 576 165\" style=\"stroke-width:0;stroke:none;fill:rgba(209,112,104,1.0)\"/><path  d=\"M 576 167\nL 596 167\nL 596 169\nL 576 169\nL 576 167\" style=\"stroke-width:0;stroke:none;fill:rgba(210,114,105,1.0)\"/><path  d=\"M 576 169\nL 596 169\nL 596 171\nL 576 171\nL 576 169\" style=\"stroke-width:0;stroke:none;fill:rgba(210,116,106,1.0)\"/><path  d=\"M 576 171\nL 596 171\nL 596 173\nL 576 173\nL 576 171\" style=\"stroke-width:0;stroke:none;fill:rgba(211,118,107,1.0)\"/><path  d=\"M 576 173\nL 596 173\nL 596 175\nL 576 175\nL 576 173\" style=\"stroke-width:0;stroke:none;fill:rgba(212,119,108,1.0)\"/><path  d=\"M 576 175\nL 596 175\nL 596 177\nL 576 177\nL 576 175\" style=\"stroke-width:0;stroke:none;fill:rgba(212,121,109,1.0)\"/><path  d=\"M 576 177\nL 596 177\nL 596 179\nL 576 179\nL 576 177\" style=\"stroke-width:0;stroke:none;fill:rgba(213,123,111,1.0)\"/><path  d=\"M 576 179\nL 596 179\nL 596 181\nL 576 181\nL 576 179\" style=\"stroke-width:0;stroke:none;fill:rgba(214,125,112,1.0)\"/><path  d=\"M 576 181\nL 596 181\nL 596 183\nL 576 183\nL 576 181\" style=\"stroke-width:0;stroke:none;fill:rgba(215,126,113,1.0)\"/><path  d=\"M 576 183\nL 596 183\nL 596 185\nL 576 185\nL 576 183\" style=\"stroke-width:0;stroke:none;fill:rgba(215,128,114,1.0)\"/><path  d=\"M 576 185\nL 596 185\nL 596 187\nL 576 187\nL 576 185\" style=\"stroke-width:0;stroke:none;fill:rgba(216,130,115,1.0)\"/><path  d=\"M 576 187\nL 596 187\nL 596 189\nL 576 189\nL 576 187\" style=\"stroke-width:0;stroke:none;fill:rgba(217,133,116,1.0)\"/><path  d=\"M 576 189\nL 596 189\nL 596 191\nL 576 191\nL 576 189\" style=\"stroke-width:0;stroke:none;fill:rgba(218,136,118,1.0)\"/><path  d=\"M 576 191\nL 596 191\nL 596 193\nL 576 193\nL 576 191\" style=\"stroke-width:0;stroke:none;fill:rgba(219,139,119,1.0)\"/><path  d=\"M 576 193\nL 596 193\nL 596 195\nL 576 195\nL 576 193\" style=\"stroke-width:0;stroke:none;fill:rgba(219,142,121,1.0)\"/><path  d=\"M 576 195\nL 596 195\nL 596 197\nL 576 197\nL 576 195\" style=\"stroke-width:0;stroke:none;fill:rgba(220,146,122,1.0)\"/><path  d=\"M 576 197\nL 596 197\nL 596 199\nL 576 199\nL 576 197\" style=\"stroke-width:0;stroke:none;fill:rgba(221,149,124,1.0)\"/><path  d=\"M 576 199\nL 596 199\nL 596 201\nL 576 201\nL 576 199\" style=\"stroke-width:0;stroke:none;fill:rgba(222,152,125,1.0)\"/><path  d=\"M 576 201\nL 596 201\nL 596 203\nL 576 203\nL 576 201\" style=\"stroke-width:0;stroke:none;fill:rgba(223,155,127,1.0)\"/><path  d=\"M 576 203\nL 596 203\nL 596 205\nL 576 205\nL 576 203\" style=\"stroke-width:0;stroke:none;fill:rgba(224,158,128,1.0)\"/><path  d=\"M 576 205\nL 596 205\nL 596 207\nL 576 207\nL 576 205\" style=\"stroke-width:0;stroke:none;fill:rgba(225,161,130,1.0)\"/><path  d=\"M 576 207\nL 596 207\nL 596 209\nL 576 209\nL 576 207\" style=\"stroke-width:0;stroke:none;fill:rgba(225,164,131,1.0)\"/><path  d=\"M 576 209\nL 596 209\nL 596 211\nL 576 211\nL 576 209\" style=\"stroke-width:0;stroke:none;fill:rgba(226,167,132,1.0)\"/><path  d=\"M 576 211\nL 596 211\nL 596 213\nL 576 213\nL 576 211\" style=\"stroke-width:0;stroke:none;fill:rgba(227,170,134,1.0)\"/><path  d=\"M 576 213\nL 596 213\nL 596 215\nL 576 215\nL 576 213\" style=\"stroke-width:0;stroke:none;fill:rgba(228,174,135,1.0)\"/><path  d=\"M 576 215\nL 596 215\nL 596 217\nL 576 217\nL 576 215\" style=\"stroke-width:0;stroke:none;fill:rgba(229,177,137,1.0)\"/><path  d=\"M 576 217\nL 596 217\nL 596 219\nL 576 219\nL 576 217\" style=\"stroke-width:0;stroke:none;fill:rgba(230,180,138,1.0)\"/><path  d=\"M 576 219\nL 596 219\nL 596 221\nL 576 221\nL 576 219\" style=\"stroke-width:0;stroke:none;fill:rgba(231,183,140,1.0)\"/><path  d=\"M 576 221\nL 596 221\nL 596 223\nL 576 223\nL 576 221\" style=\"stroke-width:0;stroke:none;fill:rgba(231,186,141,1.0)\"/><path  d=\"M 576 223\nL 596 223\nL 596 225\nL 576 225\nL 576 223\" style=\"stroke-width:0;stroke:none;fill:rgba(232,189,143,1.0)\"/><path  d=\"M 576 225\nL 596 225\nL 596 227\nL 576 227\nL 576 225\" style=\"stroke-width:0;stroke:none;fill:rgba(233,192,144,1.0)\"/><path  d=\"M 576 227\nL 596 227\nL 596 229\nL 576 229\nL 576 227\" style=\"stroke-width:0;stroke:none;fill:rgba(234,195,146,1.0)\"/><path  d=\"M 576 229\nL 596 229\nL 596 231\nL 576 231\nL 576 229\" style=\"stroke-width:0;stroke:none;fill:rgba(235,199,147,1.0)\"/><path  d=\"M 576 231\nL 596 231\nL 596 233\nL 576 233\nL 576 231\" style=\"stroke-width:0;stroke:none;fill:rgba(236,202,149,1.0)\"/><path  d=\"M 576 233\nL 596 233\nL 596 235\nL 576 235\nL 576 233\" style=\"stroke-width:0;stroke:none;fill:rgba(237,205,150,1.0)\"/><path  d=\"M 576 235\nL 596 235\nL 596 237\nL 576 237\nL 576 235\" style=\"stroke-width:0;stroke:none;fill:rgba(237,208,151,1.0)\"/><path  d=\"M 576 237\nL 596 237\nL 596 239\nL 576 239\nL 576 237\" style=\"stroke-width:0;stroke:none;fill:rgba(238,211,153,1.0)\"/><path  d=\"M 576 239\nL 596 239\nL 596 241\nL 576 241\nL 576 239\" style=\"stroke-width:0;stroke:none;fill:rgba(239,214,154,1.0)\"/><path  d=\"M 576 241\nL 596 241\nL 596 243\nL 576 243\nL 576 241\" style=\"stroke-width:0;stroke:none;fill:rgba(240,217,156,1.0)\"/><path  d=\"M 576 243\nL 596 243\nL 596 245\nL 576 245\nL 576 243\" style=\"stroke-width:0;stroke:none;fill:rgba(241,220,157,1.0)\"/><path  d=\"M 576 245\nL 596 245\nL 596 247\nL 576 247\nL 576 245\" style=\"stroke-width:0;stroke:none;fill:rgba(242,223,159,1.0)\"/><path  d=\"M 576 247\nL 596 247\nL 596 249\nL 576 249\nL 576 247\" style=\"stroke-width:0;stroke:none;fill:rgba(243,227,160,1.0)\"/><path  d=\"M 576 249\nL 596 249\nL 596 251\nL 576 251\nL 576 249\" style=\"stroke-width:0;stroke:none;fill:rgba(243,230,162,1.0)\"/><path  d=\"M 576 251\nL 596 251\nL 596 253\nL 576 253\nL 576 251\" style=\"stroke-width:0;stroke:none;fill:rgba(244,233,163,1.0)\"/><path  d=\"M 576 253\nL 596 253\nL 596 255\nL 576 255\nL 576 253\" style=\"stroke-width:0;stroke:none;fill:rgba(245,236,165,1.0)\"/><path  d=\"M 28 140\nL 202 213\nL 376 289\nL 550 364\" style=\"stroke-width:2;stroke:rgba(205,102,97,1.0);fill:none\"/><path  d=\"M 28 56\nL 202 196\nL 376 299\nL 550 323\" style=\"stroke-width:2;stroke:rgba(191,68,76,1.0);fill:none\"/><path  d=\"M 28 364\nL 202 129\nL 376 82\nL 550 113\" style=\"stroke-width:2;stroke:rgba(246,239,166,1.0);fill:none\"/><path  d=\"M 28 336\" style=\"stroke-width:2;stroke:rgba(241,219,157,1.0);fill:none\"/><path  d=\"M 376 102\nL 550 71\" style=\"stroke-width:2;stroke:rgba(241,219,157,1.0);fill:none\"/><path  d=\"M 28 28\nL 28 364\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 24 364\nL 28 364\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"7\" y=\"370\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text><path  d=\"M 24 308\nL 28 308\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"7\" y=\"314\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">90</text><path  d=\"M 24 252\nL 28 252\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"0\" y=\"258\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">100</text><path  d=\"M 24 196\nL 28 196\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"0\" y=\"202\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">110</text><path  d=\"M 24 140\nL 28 140\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"0\" y=\"146\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">120</text><path  d=\"M 24 84\nL 28 84\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"0\" y=\"90\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">130</text><path  d=\"M 24 28\nL 28 28\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"0\" y=\"34\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">140</text><text x=\"6\" y=\"12\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Latency</text><path  d=\"M 202 28\nL 202 364\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 198 364\nL 202 364\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"188\" y=\"370\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">0</text><path  d=\"M 198 297\nL 202 297\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"181\" y=\"303\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><path  d=\"M 198 230\nL 202 230\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"181\" y=\"236\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40</text><path  d=\"M 198 163\nL 202 163\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"181\" y=\"169\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">60</text><path  d=\"M 198 96\nL 202 96\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"181\" y=\"102\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text><path  d=\"M 198 28\nL 202 28\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"174\" y=\"34\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">100</text><text x=\"190\" y=\"12\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">CPU</text><path  d=\"M 376 28\nL 376 364\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 372 364\nL 376 364\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"348\" y=\"370\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">400</text><path  d=\"M 372 297\nL 376 297\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"348\" y=\"303\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">500</text><path  d=\"M 372 230\nL 376 230\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"348\" y=\"236\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">600</text><path  d=\"M 372 163\nL 376 163\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"348\" y=\"169\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">700</text><path  d=\"M 372 96\nL 376 96\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"348\" y=\"102\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">800</text><path  d=\"M 372 28\nL 376 28\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"348\" y=\"34\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">900</text><text x=\"352\" y=\"12\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Memory</text><path  d=\"M 550 28\nL 550 364\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 546 364\nL 550 364\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"525\" y=\"370\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">0.8</text><path  d=\"M 546 281\nL 550 281\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"525\" y=\"287\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">1.0</text><path  d=\"M 546 196\nL 550 196\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"525\" y=\"202\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">1.2</text><path  d=\"M 546 112\nL 550 112\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"525\" y=\"118\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">1.4</text><path  d=\"M 546 28\nL 550 28\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"525\" y=\"34\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">1.6</text><text x=\"537\" y=\"12\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Cost</text></svg>",
+		},
+	}
+
+	for _, tt := range tests {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		data, err := tt.render(p)
+		assert.Nil(err)
+		assert.Equal(tt.result, string(data))
+	}
+}
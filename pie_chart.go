@@ -25,6 +25,7 @@ package charts
 import (
 	"errors"
 	"math"
+	"strings"
 
 	"github.com/golang/freetype/truetype"
 	"github.com/wcharczuk/go-chart/v2"
@@ -70,6 +71,7 @@ type sector struct {
 	cy          int
 	rx          float64
 	ry          float64
+	innerRadius float64
 	start       float64
 	delta       float64
 	offset      int
@@ -87,14 +89,19 @@ type sector struct {
 }
 
 func NewSector(cx int, cy int, radius float64, labelRadius float64, value float64, currentValue float64, totalValue float64, labelLineLength int, label string, series Series, color Color) sector {
+	return newSector(cx, cy, radius, labelRadius, value, value/totalValue, currentValue/totalValue, value/totalValue, labelLineLength, label, series, color)
+}
+
+// newSector returns a sector, the start and angle of sector are the percent of circle
+func newSector(cx int, cy int, radius float64, labelRadius float64, value float64, percent float64, startPercent float64, anglePercent float64, labelLineLength int, label string, series Series, color Color) sector {
 	s := sector{}
 	s.value = value
-	s.percent = value / totalValue
+	s.percent = percent
 	s.cx = cx
 	s.cy = cy
 	s.rx = radius
 	s.ry = radius
-	p := (currentValue + value/2) / totalValue
+	p := startPercent + anglePercent/2
 	if p < 0.25 {
 		s.quadrant = 1
 	} else if p < 0.5 {
@@ -104,8 +111,8 @@ func NewSector(cx int, cy int, radius float64, labelRadius float64, value float6
 	} else {
 		s.quadrant = 2
 	}
-	s.start = chart.PercentToRadians(currentValue/totalValue) - math.Pi/2
-	s.delta = chart.PercentToRadians(value / totalValue)
+	s.start = chart.PercentToRadians(startPercent) - math.Pi/2
+	s.delta = chart.PercentToRadians(anglePercent)
 	angle := s.start + s.delta/2
 	s.lineStartX = cx + int(radius*math.Cos(angle))
 	s.lineStartY = cy + int(radius*math.Sin(angle))
@@ -124,6 +131,53 @@ func NewSector(cx int, cy int, radius float64, labelRadius float64, value float6
 	return s
 }
 
+// arcTo draws the outer arc of sector
+func (s *sector) arcTo(p *Painter) {
+	// 完整的圆弧起止点相同，svg无法绘制，因此拆分为两段
+	if s.delta >= 2*math.Pi {
+		p.ArcTo(s.cx, s.cy, s.rx, s.ry, s.start, math.Pi)
+		p.ArcTo(s.cx, s.cy, s.rx, s.ry, s.start+math.Pi, s.delta-math.Pi)
+		return
+	}
+	p.ArcTo(s.cx, s.cy, s.rx, s.ry, s.start, s.delta)
+}
+
+// draw draws the sector, it's a ring sector if the inner radius is set
+func (s *sector) draw(p *Painter) {
+	if s.innerRadius <= 0 {
+		p.MoveTo(s.cx, s.cy)
+		s.arcTo(p)
+		p.LineTo(s.cx, s.cy).Close().FillStroke()
+		return
+	}
+	s.arcTo(p)
+	s.innerArcTo(p)
+	p.Close().FillStroke()
+}
+
+// innerArcTo draws the inner arc of ring sector counterclockwise
+func (s *sector) innerArcTo(p *Painter) {
+	// svg的arc只支持顺时针，内圆弧以折线逆时针绘制
+	count := int(math.Ceil(s.delta / (math.Pi / 90)))
+	for i := count; i >= 0; i-- {
+		angle := s.start + s.delta*float64(i)/float64(count)
+		p.LineTo(s.cx+int(s.innerRadius*math.Cos(angle)), s.cy+int(s.innerRadius*math.Sin(angle)))
+	}
+}
+
+// drawRoundCap draws the ring sector with round caps,
+// the caps are semicircles whose diameter is the width of ring
+func (s *sector) drawRoundCap(p *Painter) {
+	capRadius := (s.rx - s.innerRadius) / 2
+	midRadius := s.innerRadius + capRadius
+	end := s.start + s.delta
+	s.arcTo(p)
+	p.ArcTo(s.cx+int(midRadius*math.Cos(end)), s.cy+int(midRadius*math.Sin(end)), capRadius, capRadius, end, math.Pi)
+	s.innerArcTo(p)
+	p.ArcTo(s.cx+int(midRadius*math.Cos(s.start)), s.cy+int(midRadius*math.Sin(s.start)), capRadius, capRadius, s.start+math.Pi, math.Pi)
+	p.Close().FillStroke()
+}
+
 func (s *sector) calculateY(prevY int) int {
 	for i := 0; i <= s.cy; i++ {
 		if s.quadrant <= 2 {
@@ -157,17 +211,31 @@ func (p *pieChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 	opt := p.opt
 	values := make([]float64, len(seriesList))
 	total := float64(0)
+	maxValue := float64(0)
 	radiusValue := ""
+	innerRadiusValue := ""
+	roseType := ""
+	var centerLabel *SeriesLabel
 	for index, series := range seriesList {
 		if len(series.Radius) != 0 {
 			radiusValue = series.Radius
 		}
+		if len(series.InnerRadius) != 0 {
+			innerRadiusValue = series.InnerRadius
+		}
+		if len(series.RoseType) != 0 {
+			roseType = series.RoseType
+		}
+		if centerLabel == nil && series.CenterLabel.Show {
+			centerLabel = &seriesList[index].CenterLabel
+		}
 		value := float64(0)
 		for _, item := range series.Data {
 			value += item.Value
 		}
 		values[index] = value
 		total += value
+		maxValue = math.Max(maxValue, value)
 	}
 	if total <= 0 {
 		return BoxZero, errors.New("The sum value of pie chart should gt 0")
@@ -178,12 +246,15 @@ func (p *pieChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 
 	diameter := chart.MinInt(seriesPainter.Width(), seriesPainter.Height())
 	radius := getRadius(float64(diameter), radiusValue)
+	innerRadius := getInnerRadius(float64(diameter), innerRadiusValue)
+	if innerRadius >= radius {
+		innerRadius = 0
+	}
 
 	labelLineWidth := 15
 	if radius < 50 {
 		labelLineWidth = 10
 	}
-	labelRadius := radius + float64(labelLineWidth)
 	seriesNames := opt.Legend.Data
 	if len(seriesNames) == 0 {
 		seriesNames = seriesList.Names()
@@ -201,7 +272,20 @@ func (p *pieChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 				color = theme.GetSeriesColor(1)
 			}
 		}
-		s := NewSector(cx, cy, radius, labelRadius, v, currentValue, total, labelLineWidth, seriesNames[index], series, color)
+		sectorRadius := radius
+		startPercent := currentValue / total
+		anglePercent := v / total
+		if roseType == PieRoseTypeRadius || roseType == PieRoseTypeArea {
+			// 南丁格尔玫瑰图，半径按数值缩放
+			sectorRadius = innerRadius + (radius-innerRadius)*v/maxValue
+		}
+		if roseType == PieRoseTypeArea {
+			startPercent = float64(index) / float64(len(values))
+			anglePercent = 1 / float64(len(values))
+		}
+		labelRadius := sectorRadius + float64(labelLineWidth)
+		s := newSector(cx, cy, sectorRadius, labelRadius, v, v/total, startPercent, anglePercent, labelLineWidth, seriesNames[index], series, color)
+		s.innerRadius = innerRadius
 		switch quadrant := s.quadrant; quadrant {
 		case 1:
 			quadrant1 = append([]sector{s}, quadrant1...)
@@ -228,8 +312,7 @@ func (p *pieChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 			StrokeColor: s.color,
 			FillColor:   s.color,
 		})
-		seriesPainter.MoveTo(s.cx, s.cy)
-		seriesPainter.ArcTo(s.cx, s.cy, s.rx, s.ry, s.start, s.delta).LineTo(s.cx, s.cy).Close().FillStroke()
+		s.draw(seriesPainter)
 		if !s.showLabel {
 			continue
 		}
@@ -276,9 +359,38 @@ func (p *pieChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 		x, y := s.calculateTextXY(seriesPainter.MeasureText(s.label))
 		seriesPainter.Text(s.label, x, y)
 	}
+	if centerLabel != nil {
+		p.renderCenterLabel(seriesPainter, *centerLabel, total, cx, cy)
+	}
 	return p.p.box, nil
 }
 
+// renderCenterLabel renders the label of donut center, it supports multi lines
+func (p *pieChart) renderCenterLabel(seriesPainter *Painter, label SeriesLabel, total float64, cx, cy int) {
+	theme := p.opt.Theme
+	textStyle := Style{
+		FontColor: theme.GetTextColor(),
+		FontSize:  label.FontSize,
+		Font:      p.opt.Font,
+	}
+	if textStyle.FontSize == 0 {
+		textStyle.FontSize = 1.5 * theme.GetFontSize()
+	}
+	if !label.Color.IsZero() {
+		textStyle.FontColor = label.Color
+	}
+	seriesPainter.OverrideTextStyle(textStyle)
+	text := NewValueLabelFormatter(nil, label.Formatter)(0, total, -1)
+	lines := strings.Split(text, "\n")
+	lineHeight := seriesPainter.MeasureText(lines[0]).Height() + 5
+	y := cy - lineHeight*len(lines)>>1
+	for _, line := range lines {
+		y += lineHeight
+		textBox := seriesPainter.MeasureText(line)
+		seriesPainter.Text(line, cx-textBox.Width()>>1, y-5)
+	}
+}
+
 func (p *pieChart) Render() (Box, error) {
 	opt := p.opt
 
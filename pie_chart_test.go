@@ -81,6 +81,99 @@ func TestPieChart(t *testing.T) {
 			},
 			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 560 0\nL 560 360\nL 0 360\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 40 49\nL 70 49\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><circle cx=\"55\" cy=\"49\" r=\"5\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"72\" y=\"55\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Search Engine</text><path  d=\"M 40 69\nL 70 69\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><circle cx=\"55\" cy=\"69\" r=\"5\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"72\" y=\"75\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Direct</text><path  d=\"M 40 89\nL 70 89\" style=\"stroke-width:3;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><circle cx=\"55\" cy=\"89\" r=\"5\" style=\"stroke-width:3;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><text x=\"72\" y=\"95\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Email</text><path  d=\"M 40 109\nL 70 109\" style=\"stroke-width:3;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><circle cx=\"55\" cy=\"109\" r=\"5\" style=\"stroke-width:3;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><text x=\"72\" y=\"115\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Union Ads</text><path  d=\"M 40 129\nL 70 129\" style=\"stroke-width:3;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><circle cx=\"55\" cy=\"129\" r=\"5\" style=\"stroke-width:3;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><path  d=\"\" style=\"stroke-width:3;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><text x=\"72\" y=\"135\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Video Ads</text><text x=\"222\" y=\"55\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Rainfall vs Evaporation</text><text x=\"266\" y=\"70\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Fake Data</text><path  d=\"M 300 210\nL 300 114\nA 96 96 119.89 0 1 383 257\nL 300 210\nZ\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"M 383 162\nL 396 155\nM 396 155\nL 411 155\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"414\" y=\"160\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Search Engine: 33.3%</text><path  d=\"M 300 210\nL 383 257\nA 96 96 84.08 0 1 262 297\nL 300 210\nZ\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"M 329 301\nL 334 315\nM 334 315\nL 349 315\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"352\" y=\"320\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Direct: 23.35%</text><path  d=\"M 300 210\nL 262 297\nA 96 96 66.35 0 1 205 210\nL 300 210\nZ\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><path  d=\"M 220 262\nL 207 270\nM 207 270\nL 192 270\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><text x=\"108\" y=\"275\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Email: 18.43%</text><path  d=\"M 300 210\nL 205 210\nA 96 96 55.37 0 1 246 131\nL 300 210\nZ\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><path  d=\"M 216 165\nL 202 158\nM 202 158\nL 187 158\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><text x=\"76\" y=\"163\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Union Ads: 15.37%</text><path  d=\"M 300 210\nL 246 131\nA 96 96 34.32 0 1 300 114\nL 300 210\nZ\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><path  d=\"M 272 119\nL 268 104\nM 268 104\nL 253 104\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><text x=\"150\" y=\"109\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Video Ads: 9.53%</text></svg>",
 		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				values := []float64{
+					1048,
+					735,
+					580,
+					484,
+					300,
+				}
+				seriesList := NewPieSeriesList(values, PieSeriesOption{
+					Label: SeriesLabel{
+						Show: true,
+					},
+					Radius:      "35%",
+					InnerRadius: "20%",
+					Names: []string{
+						"Search Engine",
+						"Direct",
+						"Email",
+						"Union Ads",
+						"Video Ads",
+					},
+				})
+				seriesList[0].CenterLabel = SeriesLabel{
+					Show:      true,
+					Formatter: "Total\n{c}",
+				}
+				_, err := NewPieChart(p, PieChartOption{
+					SeriesList: seriesList,
+					Legend: LegendOption{
+						Show: FalseFlag(),
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 560 0\nL 560 360\nL 0 360\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 300 70\nA 115 115 119.89 0 1 400 242\nL 357 217\nL 358 215\nL 359 213\nL 360 211\nL 361 209\nL 362 207\nL 362 205\nL 363 203\nL 364 200\nL 364 198\nL 365 196\nL 365 194\nL 365 191\nL 365 189\nL 365 187\nL 365 185\nL 365 183\nL 365 181\nL 365 179\nL 365 176\nL 364 174\nL 364 172\nL 364 169\nL 363 167\nL 362 165\nL 361 163\nL 361 161\nL 360 159\nL 359 157\nL 358 154\nL 357 152\nL 355 150\nL 354 149\nL 353 147\nL 351 145\nL 350 143\nL 349 141\nL 347 140\nL 345 138\nL 344 136\nL 342 135\nL 340 133\nL 338 132\nL 336 131\nL 334 130\nL 332 128\nL 330 127\nL 328 126\nL 326 125\nL 324 124\nL 322 123\nL 320 123\nL 318 122\nL 315 121\nL 313 121\nL 311 121\nL 309 120\nL 306 120\nL 304 120\nL 302 120\nL 300 119\nZ\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"M 399 128\nL 412 120\nM 412 120\nL 427 120\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"430\" y=\"125\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Search Engine: 33.3%</text><path  d=\"M 400 242\nA 115 115 84.08 0 1 254 290\nL 274 245\nL 276 246\nL 278 246\nL 280 247\nL 282 248\nL 284 248\nL 287 249\nL 289 249\nL 291 250\nL 293 250\nL 295 250\nL 298 250\nL 300 250\nL 301 250\nL 303 250\nL 306 250\nL 308 250\nL 310 250\nL 312 249\nL 315 249\nL 317 248\nL 319 248\nL 321 247\nL 323 246\nL 325 245\nL 327 244\nL 329 243\nL 331 242\nL 333 241\nL 335 240\nL 337 239\nL 339 237\nL 341 236\nL 342 235\nL 344 233\nL 346 232\nL 347 230\nL 349 228\nL 350 227\nL 352 225\nL 353 223\nL 354 221\nL 356 219\nL 357 217\nZ\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"M 335 294\nL 340 309\nM 340 309\nL 355 309\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"358\" y=\"314\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Direct: 23.35%</text><path  d=\"M 254 290\nA 115 115 66.35 0 1 185 185\nL 235 185\nL 235 186\nL 235 189\nL 235 191\nL 235 193\nL 235 195\nL 236 198\nL 236 200\nL 237 202\nL 237 204\nL 238 206\nL 239 208\nL 240 210\nL 241 212\nL 242 214\nL 243 216\nL 244 218\nL 245 220\nL 246 222\nL 248 224\nL 249 226\nL 250 228\nL 252 229\nL 253 231\nL 255 232\nL 257 234\nL 258 235\nL 260 237\nL 262 238\nL 264 239\nL 266 241\nL 268 242\nL 270 243\nL 272 244\nL 274 245\nZ\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><path  d=\"M 203 247\nL 191 255\nM 191 255\nL 176 255\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><text x=\"92\" y=\"260\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Email: 18.43%</text><path  d=\"M 185 185\nA 115 115 55.37 0 1 235 90\nL 263 131\nL 261 132\nL 260 134\nL 258 135\nL 256 137\nL 254 138\nL 253 140\nL 251 141\nL 250 143\nL 248 145\nL 247 147\nL 246 149\nL 245 151\nL 243 153\nL 242 155\nL 241 157\nL 240 159\nL 239 161\nL 239 163\nL 238 165\nL 237 167\nL 237 169\nL 236 172\nL 236 174\nL 235 176\nL 235 178\nL 235 181\nL 235 183\nL 235 185\nZ\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><path  d=\"M 199 131\nL 185 124\nM 185 124\nL 170 124\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><text x=\"59\" y=\"129\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Union Ads: 15.37%</text><path  d=\"M 235 90\nA 115 115 34.32 0 1 300 70\nL 300 119\nL 298 120\nL 296 120\nL 294 120\nL 292 120\nL 290 120\nL 287 121\nL 285 121\nL 283 122\nL 281 122\nL 279 123\nL 277 124\nL 275 125\nL 273 126\nL 271 127\nL 269 128\nL 267 129\nL 265 130\nL 263 131\nZ\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><path  d=\"M 266 75\nL 262 61\nM 262 61\nL 247 61\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><text x=\"144\" y=\"66\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Video Ads: 9.53%</text><text x=\"273\" y=\"180\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:23.0px;font-family:'Roboto Medium',sans-serif\">Total</text><text x=\"274\" y=\"208\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:23.0px;font-family:'Roboto Medium',sans-serif\">3147</text></svg>",
+		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				values := []float64{
+					1048,
+					735,
+					580,
+					484,
+					300,
+				}
+				_, err := NewPieChart(p, PieChartOption{
+					SeriesList: NewPieSeriesList(values, PieSeriesOption{
+						Label: SeriesLabel{
+							Show: true,
+						},
+						RoseType: PieRoseTypeRadius,
+					}),
+					Legend: LegendOption{
+						Show: FalseFlag(),
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 560 0\nL 560 360\nL 0 360\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 300 185\nL 300 53\nA 132 132 119.89 0 1 414 250\nL 300 185\nZ\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"M 414 119\nL 427 112\nM 427 112\nL 442 112\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"445\" y=\"117\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 33.3%</text><path  d=\"M 300 185\nL 380 231\nA 92 92 84.08 0 1 263 269\nL 300 185\nZ\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"M 328 273\nL 333 287\nM 333 287\nL 348 287\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"351\" y=\"292\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 23.35%</text><path  d=\"M 300 185\nL 271 251\nA 73 73 66.35 0 1 227 185\nL 300 185\nZ\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><path  d=\"M 239 224\nL 227 232\nM 227 232\nL 212 232\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><text x=\"160\" y=\"237\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 18.43%</text><path  d=\"M 300 185\nL 240 185\nA 60 60 55.37 0 1 266 135\nL 300 185\nZ\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><path  d=\"M 247 157\nL 233 150\nM 233 150\nL 218 150\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><text x=\"166\" y=\"155\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 15.37%</text><path  d=\"M 300 185\nL 279 154\nA 37 37 34.32 0 1 300 148\nL 300 185\nZ\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><path  d=\"M 289 149\nL 285 134\nM 285 134\nL 270 134\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/><text x=\"225\" y=\"139\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">: 9.53%</text></svg>",
+		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				values := []float64{
+					1048,
+					735,
+					580,
+					484,
+					300,
+				}
+				_, err := NewPieChart(p, PieChartOption{
+					SeriesList: NewPieSeriesList(values, PieSeriesOption{
+						Radius:      "40%",
+						InnerRadius: "10%",
+						RoseType:    PieRoseTypeArea,
+					}),
+					Legend: LegendOption{
+						Show: FalseFlag(),
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 560 0\nL 560 360\nL 0 360\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 300 53\nA 132 132 72.00 0 1 425 145\nL 331 175\nL 331 174\nL 330 173\nL 330 172\nL 329 171\nL 329 170\nL 328 169\nL 327 168\nL 327 167\nL 326 166\nL 326 165\nL 325 164\nL 324 163\nL 323 163\nL 322 162\nL 322 161\nL 321 160\nL 320 159\nL 319 159\nL 318 158\nL 317 158\nL 316 157\nL 315 156\nL 314 156\nL 313 155\nL 312 155\nL 311 154\nL 310 154\nL 309 154\nL 307 153\nL 306 153\nL 305 153\nL 304 153\nL 303 153\nL 302 153\nL 301 153\nL 300 152\nZ\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"M 397 154\nA 102 102 72.00 0 1 360 267\nL 319 211\nL 320 211\nL 321 210\nL 322 209\nL 322 208\nL 323 207\nL 324 207\nL 325 206\nL 326 205\nL 326 204\nL 327 203\nL 327 202\nL 328 201\nL 329 200\nL 329 199\nL 330 198\nL 330 197\nL 331 196\nL 331 195\nL 331 194\nL 332 192\nL 332 191\nL 332 190\nL 332 189\nL 332 188\nL 332 187\nL 332 186\nL 333 185\nL 332 184\nL 332 183\nL 332 182\nL 332 181\nL 332 180\nL 332 179\nL 332 178\nL 331 176\nL 331 175\nZ\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><path  d=\"M 254 248\nA 78 78 72.00 0 1 226 161\nL 269 175\nL 269 176\nL 268 178\nL 268 179\nL 268 180\nL 268 181\nL 268 182\nL 268 183\nL 268 184\nL 267 185\nL 268 186\nL 268 187\nL 268 188\nL 268 189\nL 268 190\nL 268 191\nL 268 192\nL 269 194\nL 269 195\nL 269 196\nL 270 197\nL 270 198\nL 271 199\nL 271 200\nL 272 201\nL 273 202\nL 273 203\nL 274 204\nL 274 205\nL 275 206\nL 276 207\nL 277 207\nL 278 208\nL 278 209\nL 279 210\nL 280 211\nL 281 211\nZ\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><path  d=\"M 351 256\nA 87 87 72.00 0 1 249 256\nL 281 211\nL 282 212\nL 283 212\nL 284 213\nL 285 214\nL 286 214\nL 287 215\nL 288 215\nL 289 216\nL 290 216\nL 291 216\nL 293 217\nL 294 217\nL 295 217\nL 296 217\nL 297 217\nL 298 217\nL 299 217\nL 300 218\nL 301 217\nL 302 217\nL 303 217\nL 304 217\nL 305 217\nL 306 217\nL 307 217\nL 309 216\nL 310 216\nL 311 216\nL 312 215\nL 313 215\nL 314 214\nL 315 214\nL 316 213\nL 317 212\nL 318 212\nL 319 211\nZ\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><path  d=\"M 242 167\nA 61 61 72.00 0 1 300 124\nL 300 152\nL 299 153\nL 298 153\nL 297 153\nL 296 153\nL 295 153\nL 294 153\nL 293 153\nL 291 154\nL 290 154\nL 289 154\nL 288 155\nL 287 155\nL 286 156\nL 285 156\nL 284 157\nL 283 158\nL 282 158\nL 281 159\nL 280 159\nL 279 160\nL 278 161\nL 278 162\nL 277 163\nL 276 163\nL 275 164\nL 274 165\nL 274 166\nL 273 167\nL 273 168\nL 272 169\nL 271 170\nL 271 171\nL 270 172\nL 270 173\nL 269 174\nL 269 175\nZ\" style=\"stroke-width:1;stroke:rgba(115,192,222,1.0);fill:rgba(115,192,222,1.0)\"/></svg>",
+		},
 	}
 	for _, tt := range tests {
 		p, err := NewPainter(PainterOptions{
@@ -0,0 +1,625 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+type polarChart struct {
+	p   *Painter
+	opt *PolarChartOption
+}
+
+type PolarOption struct {
+	// The outer radius of polar, default is 40%
+	Radius string
+	// The inner radius of polar, default is 0
+	InnerRadius string
+	// The start angle of angle axis in degree, default is 0.
+	// The 0 degree is at the top and the angle increases clockwise
+	StartAngle float64
+}
+
+type PolarChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The data series list, it supports bar and line series, or scatter series.
+	// The scatter series can not mix with bar and line series
+	SeriesList SeriesList
+	// The angle axis option, the data of x axis are the categories of angle axis.
+	// The angle axis of scatter series is value axis
+	XAxis XAxisOption
+	// The radius axis option, only the first y axis is used
+	YAxisOptions []YAxisOption
+	// The padding of polar chart
+	Padding Box
+	// The option of title
+	Title TitleOption
+	// The legend option
+	Legend LegendOption
+	// The option of polar
+	Polar PolarOption
+	// The flag for show symbol of line, set this to *false will hide symbol
+	SymbolShow *bool
+	// The stroke width of line
+	StrokeWidth float64
+	// Fill the area of line
+	FillArea bool
+	// background fill (alpha) opacity
+	Opacity uint8
+	// The symbol size of scatter, default value is 10
+	SymbolSize float64
+	// background is filled
+	backgroundIsFilled bool
+}
+
+const defaultPolarDivideCount = 5
+
+// polarCoordinate is the layout of polar coordinate system,
+// the angle axis is the circle and the radius axis is from inner radius to radius
+type polarCoordinate struct {
+	center      Point
+	radius      float64
+	innerRadius float64
+	// The start angle in radians
+	startAngle float64
+	// The range of radius axis
+	radiusRange axisRange
+}
+
+// getAngle returns the angle of percent of circle
+func (pc polarCoordinate) getAngle(percent float64) float64 {
+	return pc.startAngle + 2*math.Pi*percent
+}
+
+// getRadius returns the radius of value
+func (pc polarCoordinate) getRadius(value float64) float64 {
+	return pc.innerRadius + float64(pc.radiusRange.getHeight(value))
+}
+
+// getPoint returns the point of value at the angle
+func (pc polarCoordinate) getPoint(angle, value float64) Point {
+	return getPolygonPoint(pc.center, pc.getRadius(value), angle)
+}
+
+// NewPolarChart returns a polar chart renderer
+func NewPolarChart(p *Painter, opt PolarChartOption) *polarChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &polarChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// newPolarCoordinate returns the polar coordinate in the painter
+func (pc *polarChart) newPolarCoordinate(p *Painter, seriesList SeriesList) polarCoordinate {
+	opt := pc.opt
+	diameter := float64(chart.MinInt(p.Width(), p.Height()))
+	radius := getRadius(diameter, opt.Polar.Radius)
+	innerRadius := getInnerRadius(diameter, opt.Polar.InnerRadius)
+	if innerRadius >= radius {
+		innerRadius = 0
+	}
+	yAxisOption := YAxisOption{}
+	if len(opt.YAxisOptions) != 0 {
+		yAxisOption = opt.YAxisOptions[0]
+	}
+	divideCount := yAxisOption.DivideCount
+	if divideCount <= 0 {
+		divideCount = defaultPolarDivideCount
+	}
+	max, min := seriesList.GetMaxMin(0)
+	// 柱状图需要从0开始展示
+	if seriesList.hasBar(0) {
+		min = math.Min(min, 0)
+		max = math.Max(max, 0)
+	}
+	rangeOption := AxisRangeOption{
+		Painter:     p,
+		Min:         min,
+		Max:         max,
+		Size:        int(radius - innerRadius),
+		DivideCount: divideCount,
+	}
+	if yAxisOption.Min != nil && *yAxisOption.Min <= min {
+		rangeOption.FixedMin = yAxisOption.Min
+	}
+	if yAxisOption.Max != nil && *yAxisOption.Max >= max {
+		rangeOption.FixedMax = yAxisOption.Max
+	}
+	return polarCoordinate{
+		center: Point{
+			X: p.Width() >> 1,
+			Y: p.Height() >> 1,
+		},
+		radius:      radius,
+		innerRadius: innerRadius,
+		startAngle:  opt.Polar.StartAngle*math.Pi/180 - math.Pi/2,
+		radiusRange: NewRange(rangeOption),
+	}
+}
+
+// renderAxis renders the split lines and labels of radius axis and angle axis,
+// the labels of angle axis are skipped if there is no space
+func (pc *polarChart) renderAxis(p *Painter, coordinate polarCoordinate, angleLabels []string, anglePercents []float64, splitPercents []float64) {
+	opt := pc.opt
+	theme := opt.Theme
+	center := coordinate.center
+	radiusRange := coordinate.radiusRange
+
+	p.OverrideDrawingStyle(Style{
+		StrokeColor: theme.GetAxisSplitLineColor(),
+		StrokeWidth: 1,
+	})
+	for i := 0; i <= radiusRange.divideCount; i++ {
+		r := coordinate.innerRadius + float64(radiusRange.size*i)/float64(radiusRange.divideCount)
+		if r <= 0 {
+			continue
+		}
+		// 以圆弧绘制，避免圆形在png中变形
+		p.MoveTo(center.X+int(r), center.Y)
+		(&sector{
+			cx:    center.X,
+			cy:    center.Y,
+			rx:    r,
+			ry:    r,
+			delta: 2 * math.Pi,
+		}).arcTo(p)
+		p.Stroke()
+	}
+	for _, percent := range splitPercents {
+		angle := coordinate.getAngle(percent)
+		start := getPolygonPoint(center, coordinate.innerRadius, angle)
+		end := getPolygonPoint(center, coordinate.radius, angle)
+		p.MoveTo(start.X, start.Y)
+		p.LineTo(end.X, end.Y)
+		p.Stroke()
+	}
+
+	p.OverrideTextStyle(Style{
+		FontColor: theme.GetTextColor(),
+		FontSize:  labelFontSize,
+		Font:      opt.Font,
+	})
+	// 角度轴的文本
+	maxWidth, _ := p.MeasureTextMaxWidthHeight(angleLabels)
+	unit := 1
+	if len(angleLabels) != 0 {
+		arcLength := 2 * math.Pi * coordinate.radius / float64(len(angleLabels))
+		unit = chart.MaxInt(ceilFloatToInt(float64(maxWidth+2)/arcLength), 1)
+	}
+	offset := 5
+	labelBoxes := make([]Box, 0, len(angleLabels))
+	for index, text := range angleLabels {
+		if index%unit != 0 {
+			continue
+		}
+		point := getPolygonPoint(center, coordinate.radius, coordinate.getAngle(anglePercents[index]))
+		b := p.MeasureText(text)
+		x, y := getPolarLabelPosition(center, point, b, offset)
+		p.Text(text, x, y)
+		labelBoxes = append(labelBoxes, Box{
+			Left:   x,
+			Top:    y - b.Height(),
+			Right:  x + b.Width(),
+			Bottom: y,
+		})
+	}
+
+	// 半径轴的文本展示于起始角度的左侧
+	yAxisOption := YAxisOption{}
+	if len(opt.YAxisOptions) != 0 {
+		yAxisOption = opt.YAxisOptions[0]
+	}
+	for i, text := range radiusRange.Values() {
+		r := coordinate.innerRadius + float64(radiusRange.size*i)/float64(radiusRange.divideCount)
+		// 圆心处不展示
+		if r <= 0 {
+			continue
+		}
+		if len(yAxisOption.Formatter) != 0 {
+			text = strings.ReplaceAll(yAxisOption.Formatter, "{value}", text)
+		}
+		point := getPolygonPoint(center, r, coordinate.startAngle)
+		b := p.MeasureText(text)
+		x := point.X - b.Width() - offset
+		y := point.Y + b.Height()>>1
+		// 与角度轴文本重叠时不展示
+		if isBoxOverlapped(Box{
+			Left:   x,
+			Top:    y - b.Height(),
+			Right:  x + b.Width(),
+			Bottom: y,
+		}, labelBoxes) {
+			continue
+		}
+		p.Text(text, x, y)
+	}
+}
+
+// isBoxOverlapped returns true if the box overlaps any of the boxes
+func isBoxOverlapped(box Box, boxes []Box) bool {
+	for _, item := range boxes {
+		if box.Left < item.Right && item.Left < box.Right &&
+			box.Top < item.Bottom && item.Top < box.Bottom {
+			return true
+		}
+	}
+	return false
+}
+
+func (pc *polarChart) render(result *defaultRenderResult, seriesList SeriesList) (Box, error) {
+	opt := pc.opt
+	if len(seriesList) == 0 {
+		return BoxZero, errors.New("The series list of polar chart should not be empty")
+	}
+	// 散点图的角度轴为数值轴，无法与分类角度轴的柱状图、折线图共用
+	scatterSeriesList := seriesList.Filter(ChartTypeScatter)
+	if len(scatterSeriesList) != 0 && len(scatterSeriesList) != len(seriesList) {
+		return BoxZero, errors.New("Scatter can not mix other charts in polar")
+	}
+	isValueAngle := len(scatterSeriesList) != 0
+	seriesPainter := result.seriesPainter
+	coordinate := pc.newPolarCoordinate(seriesPainter, seriesList)
+
+	var angleRange axisRange
+	angleLabels := opt.XAxis.Data
+	anglePercents := make([]float64, 0)
+	splitPercents := make([]float64, 0)
+	if isValueAngle {
+		// 散点图的角度轴为数值轴，最大值与最小值重合
+		min := math.MaxFloat64
+		max := -math.MaxFloat64
+		for _, series := range scatterSeriesList {
+			for _, item := range series.Data {
+				if item.Value == nullValue {
+					continue
+				}
+				min = math.Min(min, item.XValue)
+				max = math.Max(max, item.XValue)
+			}
+		}
+		angleRange = NewRange(AxisRangeOption{
+			Min:         min,
+			Max:         max,
+			DivideCount: opt.XAxis.SplitNumber,
+			FixedMin:    opt.XAxis.Min,
+			FixedMax:    opt.XAxis.Max,
+		})
+		values := angleRange.Values()
+		angleLabels = values[:len(values)-1]
+		for i := range angleLabels {
+			percent := float64(i) / float64(angleRange.divideCount)
+			anglePercents = append(anglePercents, percent)
+			splitPercents = append(splitPercents, percent)
+		}
+	} else {
+		count := len(angleLabels)
+		for _, series := range seriesList {
+			count = chart.MaxInt(count, len(series.Data))
+		}
+		boundaryGap := !isFalse(opt.XAxis.BoundaryGap)
+		for i := 0; i < count; i++ {
+			percent := float64(i) / float64(count)
+			splitPercents = append(splitPercents, percent)
+			// 留白时分类位于两条分隔线的中间
+			if boundaryGap {
+				percent += 0.5 / float64(count)
+			}
+			anglePercents = append(anglePercents, percent)
+		}
+		if len(angleLabels) > count {
+			angleLabels = angleLabels[:count]
+		}
+	}
+	if len(opt.XAxis.Formatter) != 0 {
+		labels := make([]string, len(angleLabels))
+		for index, text := range angleLabels {
+			labels[index] = strings.ReplaceAll(opt.XAxis.Formatter, "{value}", text)
+		}
+		angleLabels = labels
+	}
+	pc.renderAxis(seriesPainter, coordinate, angleLabels, anglePercents, splitPercents)
+
+	rendererList := make([]Renderer, 0)
+	seriesNames := seriesList.Names()
+	newLabelPainter := func(series Series) *SeriesLabelPainter {
+		if !series.Label.Show {
+			return nil
+		}
+		labelPainter := NewSeriesLabelPainter(SeriesLabelPainterParams{
+			P:           seriesPainter,
+			SeriesNames: seriesNames,
+			Label:       series.Label,
+			Theme:       opt.Theme,
+			Font:        opt.Font,
+		})
+		rendererList = append(rendererList, labelPainter)
+		return labelPainter
+	}
+
+	barSeriesList := seriesList.Filter(ChartTypeBar)
+	if len(barSeriesList) != 0 {
+		pc.renderBars(seriesPainter, coordinate, barSeriesList, anglePercents, newLabelPainter)
+	}
+	lineSeriesList := seriesList.Filter(ChartTypeLine)
+	if len(lineSeriesList) != 0 {
+		pc.renderLines(seriesPainter, coordinate, lineSeriesList, anglePercents, newLabelPainter)
+	}
+	if isValueAngle {
+		pc.renderScatters(seriesPainter, coordinate, scatterSeriesList, angleRange, newLabelPainter)
+	}
+	err := doRender(rendererList...)
+	if err != nil {
+		return BoxZero, err
+	}
+	return pc.p.box, nil
+}
+
+// renderBars renders the bars as ring sectors, the bars of each category
+// are arranged in the angle of category
+func (pc *polarChart) renderBars(p *Painter, coordinate polarCoordinate, seriesList SeriesList, anglePercents []float64, newLabelPainter func(Series) *SeriesLabelPainter) {
+	count := len(anglePercents)
+	stackIndexes, barCount := seriesList.getStackIndexes()
+	stackValues := seriesList.getStackValues()
+	// 每个分类的柱所占的角度，其余为间隔
+	groupPercent := 0.7 / float64(count)
+	barPercent := groupPercent / float64(barCount)
+	for index, series := range seriesList {
+		seriesColor := pc.opt.Theme.GetSeriesColor(series.index)
+		labelPainter := newLabelPainter(series)
+		for j, item := range series.Data {
+			if j >= count || item.Value == nullValue {
+				continue
+			}
+			value := stackValues.values[index][j]
+			baseValue := stackValues.baseValues[index][j]
+			innerRadius := coordinate.getRadius(baseValue)
+			outerRadius := coordinate.getRadius(value + baseValue)
+			// 负值向圆心方向
+			if innerRadius > outerRadius {
+				innerRadius, outerRadius = outerRadius, innerRadius
+			}
+			fillColor := seriesColor
+			if !item.Style.FillColor.IsZero() {
+				fillColor = item.Style.FillColor
+			}
+			startPercent := anglePercents[j] - groupPercent/2 + float64(stackIndexes[index])*barPercent
+			sec := sector{
+				cx:          coordinate.center.X,
+				cy:          coordinate.center.Y,
+				rx:          outerRadius,
+				ry:          outerRadius,
+				innerRadius: innerRadius,
+				start:       coordinate.getAngle(startPercent),
+				delta:       2 * math.Pi * barPercent,
+				color:       fillColor,
+			}
+			p.OverrideDrawingStyle(Style{
+				FillColor: fillColor,
+			})
+			sec.draw(p)
+			if labelPainter == nil {
+				continue
+			}
+			point := getPolygonPoint(coordinate.center, outerRadius, sec.start+sec.delta/2)
+			labelPainter.Add(LabelValue{
+				Index:   index,
+				Value:   item.Value,
+				X:       point.X,
+				Y:       point.Y,
+				Percent: stackValues.getPercent(index, j),
+			})
+		}
+	}
+}
+
+// renderLines renders the lines which connect the values of categories,
+// the line is closed if all values are valid
+func (pc *polarChart) renderLines(p *Painter, coordinate polarCoordinate, seriesList SeriesList, anglePercents []float64, newLabelPainter func(Series) *SeriesLabelPainter) {
+	opt := pc.opt
+	count := len(anglePercents)
+	strokeWidth := opt.StrokeWidth
+	if strokeWidth == 0 {
+		strokeWidth = defaultStrokeWidth
+	}
+	stackValues := seriesList.getStackValues()
+	for index, series := range seriesList {
+		seriesColor := opt.Theme.GetSeriesColor(series.index)
+		labelPainter := newLabelPainter(series)
+		linePoints := make([]Point, 0, count+1)
+		basePoints := make([]Point, 0, count+1)
+		dotPoints := make([]Point, 0, count)
+		hasNull := len(series.Data) < count
+		for j, item := range series.Data {
+			if j >= count {
+				continue
+			}
+			angle := coordinate.getAngle(anglePercents[j])
+			if item.Value == nullValue {
+				hasNull = true
+				linePoints = append(linePoints, Point{
+					Y: int(math.MaxInt32),
+				})
+				continue
+			}
+			value := stackValues.values[index][j]
+			baseValue := stackValues.baseValues[index][j]
+			point := coordinate.getPoint(angle, value+baseValue)
+			linePoints = append(linePoints, point)
+			dotPoints = append(dotPoints, point)
+			basePoints = append(basePoints, coordinate.getPoint(angle, math.Max(baseValue, coordinate.radiusRange.min)))
+			if labelPainter == nil {
+				continue
+			}
+			labelPainter.Add(LabelValue{
+				Index:   index,
+				Value:   item.Value,
+				X:       point.X,
+				Y:       point.Y,
+				Percent: stackValues.getPercent(index, j),
+			})
+		}
+		// 数据完整时首尾相连
+		if !hasNull && len(linePoints) > 2 {
+			linePoints = append(linePoints, linePoints[0])
+			basePoints = append(basePoints, basePoints[0])
+		}
+		if opt.FillArea && !hasNull && len(linePoints) > 2 {
+			var opacity uint8 = 200
+			if opt.Opacity != 0 {
+				opacity = opt.Opacity
+			}
+			reversePointSlice(basePoints)
+			p.SetDrawingStyle(Style{
+				FillColor: seriesColor.WithAlpha(opacity),
+			})
+			// 区域为线与基准线之间的圆环，分为两个闭合路径填充
+			for _, points := range [][]Point{
+				linePoints,
+				basePoints,
+			} {
+				for i, point := range points {
+					if i == 0 {
+						p.MoveTo(point.X, point.Y)
+					} else {
+						p.LineTo(point.X, point.Y)
+					}
+				}
+				p.Close()
+			}
+			p.Fill()
+		}
+		drawingStyle := Style{
+			StrokeColor: seriesColor,
+			StrokeWidth: strokeWidth,
+		}
+		if len(series.Style.StrokeDashArray) > 0 {
+			drawingStyle.StrokeDashArray = series.Style.StrokeDashArray
+		}
+		p.SetDrawingStyle(drawingStyle)
+		p.LineStroke(linePoints)
+
+		if isFalse(opt.SymbolShow) {
+			continue
+		}
+		if opt.Theme.IsDark() {
+			drawingStyle.FillColor = drawingStyle.StrokeColor
+		} else {
+			drawingStyle.FillColor = drawing.ColorWhite
+		}
+		drawingStyle.StrokeWidth = 1
+		p.SetDrawingStyle(drawingStyle)
+		p.Dots(dotPoints)
+	}
+}
+
+// renderScatters renders the scatter points, the angle is decided by the x value
+func (pc *polarChart) renderScatters(p *Painter, coordinate polarCoordinate, seriesList SeriesList, angleRange axisRange, newLabelPainter func(Series) *SeriesLabelPainter) {
+	symbolSize := pc.opt.SymbolSize
+	if symbolSize <= 0 {
+		symbolSize = defaultScatterSymbolSize
+	}
+	// 气泡图的size按最大值等比缩放
+	maxSize := float64(0)
+	for _, series := range seriesList {
+		for _, item := range series.Data {
+			maxSize = math.Max(maxSize, item.Size)
+		}
+	}
+	for index, series := range seriesList {
+		seriesColor := pc.opt.Theme.GetSeriesColor(series.index)
+		labelPainter := newLabelPainter(series)
+		for _, item := range series.Data {
+			if item.Value == nullValue {
+				continue
+			}
+			percent := 0.0
+			if angleRange.max > angleRange.min {
+				percent = (item.XValue - angleRange.min) / (angleRange.max - angleRange.min)
+			}
+			point := coordinate.getPoint(coordinate.getAngle(percent), item.Value)
+			size := symbolSize
+			if maxSize > 0 && item.Size > 0 {
+				// 面积与值成正比
+				size = math.Sqrt(item.Size/maxSize) * defaultBubbleMaxSymbolSize
+			}
+			fillColor := seriesColor
+			if !item.Style.FillColor.IsZero() {
+				fillColor = item.Style.FillColor
+			}
+			p.OverrideDrawingStyle(Style{
+				StrokeColor: fillColor,
+				StrokeWidth: 1,
+				FillColor:   fillColor.WithAlpha(200),
+			})
+			p.Circle(size/2, point.X, point.Y)
+			p.FillStroke()
+			if labelPainter == nil {
+				continue
+			}
+			labelPainter.Add(LabelValue{
+				Index: index,
+				Value: item.Value,
+				X:     point.X,
+				Y:     point.Y,
+			})
+		}
+	}
+}
+
+func (pc *polarChart) Render() (Box, error) {
+	p := pc.p
+	opt := pc.opt
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		Theme:      opt.Theme,
+		Padding:    opt.Padding,
+		SeriesList: opt.SeriesList,
+		XAxis: XAxisOption{
+			Show: FalseFlag(),
+		},
+		YAxisOptions: []YAxisOption{
+			{
+				Show: FalseFlag(),
+			},
+		},
+		TitleOption:        opt.Title,
+		LegendOption:       opt.Legend,
+		backgroundIsFilled: opt.backgroundIsFilled,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return pc.render(renderResult, opt.SeriesList)
+}
@@ -0,0 +1,293 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPolarAngles(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]float64{
+		-math.Pi / 2,
+		0,
+		math.Pi / 2,
+		math.Pi,
+	}, getPolarAngles(4, -math.Pi/2))
+	assert.Equal(getPolarAngles(5, -math.Pi/2), getPolygonPointAngles(5))
+}
+
+func TestPolarCoordinate(t *testing.T) {
+	assert := assert.New(t)
+
+	coordinate := polarCoordinate{
+		center: Point{
+			X: 100,
+			Y: 100,
+		},
+		radius:      60,
+		innerRadius: 10,
+		startAngle:  -math.Pi / 2,
+		radiusRange: NewRange(AxisRangeOption{
+			Min:         0,
+			Max:         50,
+			Size:        50,
+			DivideCount: 5,
+		}),
+	}
+	assert.Equal(math.Pi/2, coordinate.getAngle(0.5))
+	assert.Equal(10.0, coordinate.getRadius(0))
+	assert.Equal(60.0, coordinate.getRadius(50))
+	assert.Equal(Point{
+		X: 100,
+		Y: 70,
+	}, coordinate.getPoint(coordinate.getAngle(0), 20))
+	assert.Equal(Point{
+		X: 130,
+		Y: 100,
+	}, coordinate.getPoint(coordinate.getAngle(0.25), 20))
+
+	p, err := NewPainter(PainterOptions{
+		Width:  400,
+		Height: 400,
+	})
+	assert.Nil(err)
+	seriesList := NewSeriesListDataFromValues([][]float64{
+		{
+			1,
+			2,
+		},
+	}, ChartTypeBar)
+	// 内半径为0时不展示空心
+	coordinate = NewPolarChart(p, PolarChartOption{
+		Polar: PolarOption{
+			Radius:      "45%",
+			InnerRadius: "0%",
+		},
+	}).newPolarCoordinate(p, seriesList)
+	assert.Equal(180.0, coordinate.radius)
+	assert.Equal(0.0, coordinate.innerRadius)
+	coordinate = NewPolarChart(p, PolarChartOption{
+		Polar: PolarOption{
+			Radius:      "40%",
+			InnerRadius: "10%",
+		},
+	}).newPolarCoordinate(p, seriesList)
+	assert.Equal(40.0, coordinate.innerRadius)
+}
+
+func TestIsBoxOverlapped(t *testing.T) {
+	assert := assert.New(t)
+
+	boxes := []Box{
+		{
+			Left:   0,
+			Top:    0,
+			Right:  10,
+			Bottom: 10,
+		},
+	}
+	assert.True(isBoxOverlapped(Box{
+		Left:   5,
+		Top:    5,
+		Right:  15,
+		Bottom: 15,
+	}, boxes))
+	assert.False(isBoxOverlapped(Box{
+		Left:   10,
+		Top:    0,
+		Right:  20,
+		Bottom: 10,
+	}, boxes))
+}
+
+func TestPolarChart(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		render func(*Painter) ([]byte, error)
+		result string
+	}{
+		{
+			render: func(p *Painter) ([]byte, error) {
+				seriesList := NewSeriesListDataFromValues([][]float64{
+					{
+						120,
+						200,
+						150,
+						80,
+						70,
+						110,
+					},
+					{
+						60,
+						90,
+						120,
+						50,
+						40,
+						80,
+					},
+				})
+				seriesList[1].Label.Show = true
+				_, err := NewPolarChart(p, PolarChartOption{
+					Title: TitleOption{
+						Text: "Requests",
+					},
+					Polar: PolarOption{
+						InnerRadius: "10%",
+					},
+					SeriesList: seriesList,
+					XAxis: NewXAxisOption([]string{
+						"0",
+						"4",
+						"8",
+						"12",
+						"16",
+						"20",
+					}),
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"0\" y=\"15\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Requests</text><path  d=\"M 333 202\nL 333 202\nA 33 33 180.00 0 1 267 202\nL 267 202\nA 33 33 180.00 0 1 333 202\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 358 202\nL 358 202\nA 58 58 180.00 0 1 242 202\nL 242 202\nA 58 58 180.00 0 1 358 202\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 383 202\nL 383 202\nA 83 83 180.00 0 1 217 202\nL 217 202\nA 83 83 180.00 0 1 383 202\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 408 202\nL 408 202\nA 108 108 180.00 0 1 192 202\nL 192 202\nA 108 108 180.00 0 1 408 202\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 433 202\nL 433 202\nA 133 133 180.00 0 1 167 202\nL 167 202\nA 133 133 180.00 0 1 433 202\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 300 169\nL 300 68\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 329 186\nL 416 135\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 329 218\nL 416 268\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 300 235\nL 300 336\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 271 218\nL 184 269\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 271 186\nL 184 135\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><text x=\"371\" y=\"91\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"439\" y=\"208\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"372\" y=\"323\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">8</text><text x=\"213\" y=\"323\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">12</text><text x=\"146\" y=\"208\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">16</text><text x=\"214\" y=\"91\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"287\" y=\"175\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"280\" y=\"150\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">50</text><text x=\"273\" y=\"125\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">100</text><text x=\"273\" y=\"100\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">150</text><text x=\"273\" y=\"75\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">200</text><path  d=\"M 346 122\nL 433 202\nL 354 295\nL 264 265\nL 232 202\nL 256 126\nL 346 122\" style=\"stroke-width:2;stroke:rgba(84,112,198,1.0);fill:none\"/><circle cx=\"346\" cy=\"122\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"433\" cy=\"202\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"354\" cy=\"295\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"264\" cy=\"265\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"232\" cy=\"202\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"256\" cy=\"126\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"M 331 148\nL 378 202\nL 346 282\nL 271 252\nL 247 202\nL 264 139\nL 331 148\" style=\"stroke-width:2;stroke:rgba(145,204,117,1.0);fill:none\"/><circle cx=\"331\" cy=\"148\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"378\" cy=\"202\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"346\" cy=\"282\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"271\" cy=\"252\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"247\" cy=\"202\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"264\" cy=\"139\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><text x=\"325\" y=\"143\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">60</text><text x=\"372\" y=\"197\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">90</text><text x=\"335\" y=\"277\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"265\" y=\"247\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">50</text><text x=\"241\" y=\"197\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">40</text><text x=\"258\" y=\"134\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">80</text></svg>",
+		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				seriesList := NewSeriesListDataFromValues([][]float64{
+					{
+						10,
+						20,
+						30,
+						20,
+						10,
+						5,
+					},
+					{
+						5,
+						8,
+						nullValue,
+						6,
+						9,
+						4,
+					},
+				}, ChartTypeLine)
+				_, err := NewPolarChart(p, PolarChartOption{
+					Polar: PolarOption{
+						StartAngle: 90,
+					},
+					SeriesList: seriesList,
+					XAxis: XAxisOption{
+						Data: []string{
+							"A",
+							"B",
+							"C",
+							"D",
+							"E",
+							"F",
+						},
+						BoundaryGap: FalseFlag(),
+					},
+					FillArea: true,
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 324 185\nL 324 185\nA 24 24 180.00 0 1 276 185\nL 276 185\nA 24 24 180.00 0 1 324 185\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 349 185\nL 349 185\nA 49 49 180.00 0 1 251 185\nL 251 185\nA 49 49 180.00 0 1 349 185\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 374 185\nL 374 185\nA 74 74 180.00 0 1 226 185\nL 226 185\nA 74 74 180.00 0 1 374 185\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 398 185\nL 398 185\nA 98 98 180.00 0 1 202 185\nL 202 185\nA 98 98 180.00 0 1 398 185\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 423 185\nL 423 185\nA 123 123 180.00 0 1 177 185\nL 177 185\nA 123 123 180.00 0 1 423 185\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 448 185\nL 448 185\nA 148 148 180.00 0 1 152 185\nL 152 185\nA 148 148 180.00 0 1 448 185\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 300 185\nL 448 185\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 300 185\nL 374 313\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 300 185\nL 227 313\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 300 185\nL 152 185\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 300 185\nL 226 57\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 300 185\nL 374 57\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><text x=\"453\" y=\"191\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">A</text><text x=\"379\" y=\"318\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">B</text><text x=\"213\" y=\"318\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">C</text><text x=\"138\" y=\"191\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">D</text><text x=\"213\" y=\"62\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">E</text><text x=\"379\" y=\"62\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">F</text><text x=\"311\" y=\"191\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">5</text><text x=\"329\" y=\"191\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">10</text><text x=\"354\" y=\"191\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">15</text><text x=\"378\" y=\"191\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">20</text><text x=\"403\" y=\"191\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">25</text><text x=\"428\" y=\"191\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">30</text><path  d=\"M 349 185\nL 349 269\nL 227 313\nL 202 185\nL 276 143\nL 312 165\nL 349 185\nZ\nM 300 185\nL 300 185\nL 300 185\nL 300 185\nL 300 185\nL 300 185\nL 300 185\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,0.8)\"/><path  d=\"M 349 185\nL 349 269\nL 227 313\nL 202 185\nL 276 143\nL 312 165\nL 349 185\" style=\"stroke-width:2;stroke:rgba(84,112,198,1.0);fill:none\"/><circle cx=\"349\" cy=\"185\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"349\" cy=\"269\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"227\" cy=\"313\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"202\" cy=\"185\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"276\" cy=\"143\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"312\" cy=\"165\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"M 324 185\nL 319 218\" style=\"stroke-width:2;stroke:rgba(145,204,117,1.0);fill:none\"/><path  d=\"M 271 185\nL 278 147\nL 309 169\" style=\"stroke-width:2;stroke:rgba(145,204,117,1.0);fill:none\"/><circle cx=\"324\" cy=\"185\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"319\" cy=\"218\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"271\" cy=\"185\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"278\" cy=\"147\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><circle cx=\"309\" cy=\"169\" r=\"2\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(255,255,255,1.0)\"/></svg>",
+		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewPolarChart(p, PolarChartOption{
+					SeriesList: NewScatterSeriesList([][][]float64{
+						{
+							{
+								10,
+								5,
+							},
+							{
+								90,
+								8,
+							},
+							{
+								180,
+								3,
+							},
+							{
+								270,
+								9,
+								20,
+							},
+						},
+					}),
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 337 185\nL 337 185\nA 37 37 180.00 0 1 263 185\nL 263 185\nA 37 37 180.00 0 1 337 185\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 374 185\nL 374 185\nA 74 74 180.00 0 1 226 185\nL 226 185\nA 74 74 180.00 0 1 374 185\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 411 185\nL 411 185\nA 111 111 180.00 0 1 189 185\nL 189 185\nA 111 111 180.00 0 1 411 185\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 448 185\nL 448 185\nA 148 148 180.00 0 1 152 185\nL 152 185\nA 148 148 180.00 0 1 448 185\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 300 185\nL 300 37\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 300 185\nL 428 111\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 300 185\nL 428 258\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 300 185\nL 300 333\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 300 185\nL 172 259\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 300 185\nL 172 111\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><text x=\"296\" y=\"30\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">0</text><text x=\"433\" y=\"116\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">50</text><text x=\"433\" y=\"263\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">100</text><text x=\"289\" y=\"350\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">150</text><text x=\"145\" y=\"264\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">200</text><text x=\"145\" y=\"116\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">250</text><text x=\"287\" y=\"154\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">4</text><text x=\"287\" y=\"117\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">6</text><text x=\"287\" y=\"80\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">8</text><text x=\"280\" y=\"43\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">10</text><circle cx=\"311\" cy=\"132\" r=\"5\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,0.8)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,0.8)\"/><circle cx=\"405\" cy=\"219\" r=\"5\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,0.8)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,0.8)\"/><circle cx=\"290\" cy=\"199\" r=\"5\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,0.8)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,0.8)\"/><circle cx=\"225\" cy=\"81\" r=\"20\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,0.8)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,0.8)\"/></svg>",
+		},
+	}
+
+	for _, tt := range tests {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		data, err := tt.render(p)
+		assert.Nil(err)
+		assert.Equal(tt.result, string(data))
+	}
+	p, err := NewPainter(PainterOptions{
+		Type:   ChartOutputSVG,
+		Width:  600,
+		Height: 400,
+	})
+	assert.Nil(err)
+	seriesList := append(NewSeriesListDataFromValues([][]float64{
+		{
+			1,
+			2,
+		},
+	}, ChartTypeBar), NewScatterSeriesList([][][]float64{
+		{
+			{
+				1,
+				2,
+			},
+		},
+	})...)
+	_, err = NewPolarChart(p, PolarChartOption{
+		SeriesList: seriesList,
+	}).Render()
+	assert.Equal("Scatter can not mix other charts in polar", err.Error())
+}
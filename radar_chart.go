@@ -157,37 +157,7 @@ func (r *radarChart) render(result *defaultRenderResult, seriesList SeriesList)
 	for index, p := range points {
 		name := indicators[index].Name
 		b := seriesPainter.MeasureText(name)
-		isXCenter := p.X == center.X
-		isYCenter := p.Y == center.Y
-		isRight := p.X > center.X
-		isLeft := p.X < center.X
-		isTop := p.Y < center.Y
-		isBottom := p.Y > center.Y
-		x := p.X
-		y := p.Y
-		if isXCenter {
-			x -= b.Width() >> 1
-			if isTop {
-				y -= b.Height()
-			} else {
-				y += b.Height()
-			}
-		}
-		if isYCenter {
-			y += b.Height() >> 1
-		}
-		if isTop {
-			y += offset
-		}
-		if isBottom {
-			y += offset
-		}
-		if isRight {
-			x += offset
-		}
-		if isLeft {
-			x -= (b.Width() + offset)
-		}
+		x, y := getPolarLabelPosition(center, p, b, offset)
 		seriesPainter.Text(name, x, y)
 	}
 
@@ -0,0 +1,306 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+type radialBarChart struct {
+	p   *Painter
+	opt *RadialBarChartOption
+}
+
+// NewRadialBarSeriesList returns a series list for radial bar chart,
+// each value is a ring and the max value of ring is set if it's not null
+func NewRadialBarSeriesList(values, maxValues []float64) SeriesList {
+	seriesList := make(SeriesList, len(values))
+	for index, value := range values {
+		seriesList[index] = NewSeriesFromValues([]float64{
+			value,
+		}, ChartTypeRadialBar)
+		if index < len(maxValues) && maxValues[index] != nullValue {
+			seriesList[index].Max = NewFloatPoint(maxValues[index])
+		}
+	}
+	return seriesList
+}
+
+// NewRadialBarChart returns a radial bar chart renderer
+func NewRadialBarChart(p *Painter, opt RadialBarChartOption) *radialBarChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &radialBarChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+type RadialBarOption struct {
+	// The outer radius of radial bar, default is 40%
+	Radius string
+	// The inner radius of radial bar, default is 20%
+	InnerRadius string
+	// The start angle of ring in degree, default is 0.
+	// The 0 degree is at the top and the angle increases clockwise
+	StartAngle float64
+	// The max value of ring, default is 100.
+	// The max value of series has higher priority
+	Max float64
+	// Draw the ends of ring as round caps
+	RoundCap bool
+	// The flag for show background track, set this to *false will hide track
+	TrackShow *bool
+	// The color of background track, default is the split line color of theme
+	TrackColor Color
+	// The flag for show label, set this to *false will hide label and *true will always show label.
+	// The label is shown if the space between rings is enough by default
+	LabelShow *bool
+}
+
+type RadialBarChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The data series list, each series is a ring from outside to inside
+	SeriesList SeriesList
+	// The padding of radial bar chart
+	Padding Box
+	// The option of title
+	Title TitleOption
+	// The option of radial bar
+	RadialBar RadialBarOption
+	// The width of ring, default is decided by the radius and the count of ring
+	BarWidth int
+	// background is filled
+	backgroundIsFilled bool
+}
+
+const defaultRadialBarMax = 100.0
+
+// NewRadialBarLabelFormatter returns a radial bar label formatter,
+// the default layout is the name and the percent of ring
+func NewRadialBarLabelFormatter(seriesNames []string, layout string) LabelFormatter {
+	if len(layout) == 0 {
+		layout = "{b} {d}"
+	}
+	formatter := NewLabelFormatter(seriesNames, layout)
+	return func(index int, value, percent float64) string {
+		return strings.TrimSpace(formatter(index, value, percent))
+	}
+}
+
+// getPercent returns the percent of ring, it's between 0 and 1
+func (opt *RadialBarOption) getPercent(series Series, value float64) float64 {
+	max := opt.Max
+	if max <= 0 {
+		max = defaultRadialBarMax
+	}
+	if series.Max != nil && *series.Max > 0 {
+		max = *series.Max
+	}
+	return math.Max(math.Min(value/max, 1), 0)
+}
+
+// getRadialBarLabelPosition returns the position and rotation of label which is before the start of ring,
+// the label is along the tangent and it's rotated 180 degree if it's upside down
+func getRadialBarLabelPosition(start Point, angle float64, textBox Box, offset int) (int, int, float64) {
+	// 顺时针方向的切线
+	tx := -math.Sin(angle)
+	ty := math.Cos(angle)
+	width := float64(textBox.Width())
+	height := float64(textBox.Height())
+	rotation := angle + math.Pi/2
+	// 文本从起始点之前开始，结束于起始点
+	distance := float64(offset) + width
+	normal := height / 2
+	if math.Cos(rotation) < -niceEpsilon {
+		rotation += math.Pi
+		distance = float64(offset)
+		normal = -normal
+	}
+	x := float64(start.X) - tx*distance - ty*normal
+	y := float64(start.Y) - ty*distance + tx*normal
+	// 取整避免浮点误差产生的旋转
+	if math.Abs(math.Sin(rotation)) < niceEpsilon {
+		rotation = 0
+	}
+	return int(math.Round(x)), int(math.Round(y)), rotation
+}
+
+func (r *radialBarChart) render(result *defaultRenderResult, seriesList SeriesList) (Box, error) {
+	opt := r.opt
+	if len(seriesList) == 0 {
+		return BoxZero, errors.New("The series list of radial bar chart should not be empty")
+	}
+	theme := opt.Theme
+	seriesPainter := result.seriesPainter
+	radialBar := opt.RadialBar
+
+	diameter := float64(chart.MinInt(seriesPainter.Width(), seriesPainter.Height()))
+	radius := getRadius(diameter, radialBar.Radius)
+	innerRadius := diameter * 0.2
+	if len(radialBar.InnerRadius) != 0 {
+		innerRadius = getInnerRadius(diameter, radialBar.InnerRadius)
+	}
+	if innerRadius >= radius {
+		innerRadius = 0
+	}
+	// 每个圆环所占的宽度，其余为间隔
+	count := len(seriesList)
+	pitch := (radius - innerRadius) / float64(count)
+	ringWidth := math.Min(pitch*0.7, radius*0.15)
+	if opt.BarWidth > 0 {
+		ringWidth = math.Min(float64(opt.BarWidth), pitch)
+	}
+	pitch = math.Min(pitch, ringWidth/0.7)
+	trackColor := radialBar.TrackColor
+	if trackColor.IsZero() {
+		trackColor = theme.GetAxisSplitLineColor()
+	}
+	startAngle := radialBar.StartAngle*math.Pi/180 - math.Pi/2
+	cx := seriesPainter.Width() >> 1
+	cy := seriesPainter.Height() >> 1
+
+	seriesNames := seriesList.Names()
+	labelStyle := Style{
+		FontColor: theme.GetTextColor(),
+		FontSize:  labelFontSize,
+		Font:      opt.Font,
+	}
+	seriesPainter.OverrideTextStyle(labelStyle)
+	// 圆环的间距小于文本高度时，文本会重叠，因此不展示
+	_, textHeight := seriesPainter.MeasureTextMaxWidthHeight(seriesNames)
+	labelShow := pitch >= float64(textHeight)
+	if radialBar.LabelShow != nil {
+		labelShow = *radialBar.LabelShow
+	}
+	for index, series := range seriesList {
+		if len(series.Data) == 0 {
+			continue
+		}
+		outerRadius := radius - pitch*float64(index)
+		sec := sector{
+			cx:          cx,
+			cy:          cy,
+			rx:          outerRadius,
+			ry:          outerRadius,
+			innerRadius: outerRadius - ringWidth,
+			start:       startAngle,
+			delta:       2 * math.Pi,
+		}
+		// 背景轨道
+		if !isFalse(radialBar.TrackShow) {
+			seriesPainter.OverrideDrawingStyle(Style{
+				FillColor: trackColor,
+			})
+			sec.draw(seriesPainter)
+		}
+
+		value := series.Data[0].Value
+		// 空值只展示背景轨道
+		if value == nullValue {
+			continue
+		}
+		percent := radialBar.getPercent(series, value)
+		color := theme.GetSeriesColor(series.index)
+		if !series.Data[0].Style.FillColor.IsZero() {
+			color = series.Data[0].Style.FillColor
+		}
+		sec.delta = 2 * math.Pi * percent
+		sec.color = color
+		if percent > 0 {
+			seriesPainter.OverrideDrawingStyle(Style{
+				FillColor: color,
+			})
+			if radialBar.RoundCap {
+				sec.drawRoundCap(seriesPainter)
+			} else {
+				sec.draw(seriesPainter)
+			}
+		}
+
+		// 未指定是否展示时，系列的label可强制展示
+		if !labelShow && (radialBar.LabelShow != nil || !series.Label.Show) {
+			continue
+		}
+		text := NewRadialBarLabelFormatter(seriesNames, series.Label.Formatter)(index, value, percent)
+		style := labelStyle
+		if !series.Label.Color.IsZero() {
+			style.FontColor = series.Label.Color
+		}
+		seriesPainter.OverrideTextStyle(style)
+		start := getPolygonPoint(Point{
+			X: cx,
+			Y: cy,
+		}, outerRadius-ringWidth/2, startAngle)
+		offset := 5
+		// 圆角时文本需要避开半圆
+		if radialBar.RoundCap {
+			offset += int(ringWidth / 2)
+		}
+		x, y, rotation := getRadialBarLabelPosition(start, startAngle, seriesPainter.MeasureText(text), offset)
+		if rotation == 0 {
+			seriesPainter.Text(text, x, y)
+		} else {
+			seriesPainter.TextRotation(text, x, y, rotation)
+		}
+	}
+
+	return r.p.box, nil
+}
+
+func (r *radialBarChart) Render() (Box, error) {
+	p := r.p
+	opt := r.opt
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		Theme:      opt.Theme,
+		Padding:    opt.Padding,
+		SeriesList: opt.SeriesList,
+		XAxis: XAxisOption{
+			Show: FalseFlag(),
+		},
+		YAxisOptions: []YAxisOption{
+			{
+				Show: FalseFlag(),
+			},
+		},
+		TitleOption: opt.Title,
+		LegendOption: LegendOption{
+			Show: FalseFlag(),
+		},
+		backgroundIsFilled: opt.backgroundIsFilled,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	seriesList := opt.SeriesList.Filter(ChartTypeRadialBar)
+	return r.render(renderResult, seriesList)
+}
@@ -0,0 +1,265 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRadialBarSeriesList(t *testing.T) {
+	assert := assert.New(t)
+
+	seriesList := NewRadialBarSeriesList([]float64{
+		72,
+		45,
+	}, []float64{
+		nullValue,
+		60,
+	})
+	assert.Equal(2, len(seriesList))
+	assert.Equal(ChartTypeRadialBar, seriesList[0].Type)
+	assert.Nil(seriesList[0].Max)
+	assert.Equal(60.0, *seriesList[1].Max)
+
+	opt := RadialBarOption{}
+	assert.Equal(0.72, opt.getPercent(seriesList[0], 72))
+	assert.Equal(0.75, opt.getPercent(seriesList[1], 45))
+	assert.Equal(1.0, opt.getPercent(seriesList[1], 90))
+	assert.Equal(0.0, opt.getPercent(seriesList[1], -10))
+	opt.Max = 200
+	assert.Equal(0.36, opt.getPercent(seriesList[0], 72))
+}
+
+func TestNewRadialBarLabelFormatter(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("CPU 72%", NewRadialBarLabelFormatter([]string{
+		"CPU",
+	}, "")(0, 72, 0.72))
+	assert.Equal("72%", NewRadialBarLabelFormatter(nil, "")(0, 72, 0.72))
+	assert.Equal("72/100", NewRadialBarLabelFormatter(nil, "{c}/100")(0, 72, 0.72))
+}
+
+func TestGetRadialBarLabelPosition(t *testing.T) {
+	assert := assert.New(t)
+
+	start := Point{
+		X: 100,
+		Y: 50,
+	}
+	textBox := Box{
+		Right:  40,
+		Bottom: 10,
+	}
+	// 起始点位于顶部，文本位于左侧
+	x, y, rotation := getRadialBarLabelPosition(start, -math.Pi/2, textBox, 5)
+	assert.Equal(55, x)
+	assert.Equal(55, y)
+	assert.Equal(0.0, rotation)
+
+	// 起始点位于右侧，文本沿切线向下
+	x, y, rotation = getRadialBarLabelPosition(start, 0, textBox, 5)
+	assert.Equal(95, x)
+	assert.Equal(5, y)
+	assert.Equal(math.Pi/2, rotation)
+
+	// 起始点位于底部，文本旋转180度后位于右侧
+	x, y, rotation = getRadialBarLabelPosition(start, math.Pi/2, textBox, 5)
+	assert.Equal(105, x)
+	assert.Equal(55, y)
+	assert.Equal(0.0, rotation)
+}
+
+func TestRadialBarChart(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		render func(*Painter) ([]byte, error)
+		result string
+	}{
+		{
+			render: func(p *Painter) ([]byte, error) {
+				seriesList := NewRadialBarSeriesList([]float64{
+					72,
+					45,
+					91,
+				}, nil)
+				for index, name := range []string{
+					"CPU",
+					"Memory",
+					"Disk",
+				} {
+					seriesList[index].Name = name
+				}
+				_, err := NewRadialBarChart(p, RadialBarChartOption{
+					Title: TitleOption{
+						Text: "Quota",
+					},
+					SeriesList: seriesList,
+					RadialBar: RadialBarOption{
+						RoundCap: true,
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"0\" y=\"15\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Quota</text><path  d=\"M 300 68\nA 134 134 180.00 0 1 300 336\nL 300 336\nA 134 134 180.00 0 1 300 68\nL 300 84\nL 296 84\nL 292 84\nL 288 85\nL 284 85\nL 280 86\nL 276 87\nL 272 88\nL 268 89\nL 264 90\nL 260 91\nL 256 93\nL 252 94\nL 249 96\nL 245 98\nL 241 100\nL 238 102\nL 234 104\nL 231 107\nL 228 109\nL 224 112\nL 221 115\nL 218 117\nL 215 120\nL 213 123\nL 210 126\nL 207 130\nL 205 133\nL 202 136\nL 200 140\nL 198 143\nL 196 147\nL 194 151\nL 192 154\nL 191 158\nL 189 162\nL 188 166\nL 187 170\nL 186 174\nL 185 178\nL 184 182\nL 183 186\nL 183 190\nL 182 194\nL 182 198\nL 182 202\nL 182 206\nL 182 210\nL 183 214\nL 183 218\nL 184 222\nL 185 226\nL 186 230\nL 187 234\nL 188 238\nL 189 242\nL 191 246\nL 192 250\nL 194 253\nL 196 257\nL 198 261\nL 200 264\nL 202 268\nL 205 271\nL 207 274\nL 210 278\nL 213 281\nL 215 284\nL 218 287\nL 221 289\nL 224 292\nL 228 295\nL 231 297\nL 234 300\nL 238 302\nL 241 304\nL 245 306\nL 249 308\nL 252 310\nL 256 311\nL 260 313\nL 264 314\nL 268 315\nL 272 316\nL 276 317\nL 280 318\nL 284 319\nL 288 319\nL 292 320\nL 296 320\nL 300 320\nL 304 320\nL 308 320\nL 312 319\nL 316 319\nL 320 318\nL 324 317\nL 328 316\nL 332 315\nL 336 314\nL 340 313\nL 344 311\nL 348 310\nL 351 308\nL 355 306\nL 359 304\nL 362 302\nL 366 300\nL 369 297\nL 372 295\nL 376 292\nL 379 289\nL 382 287\nL 385 284\nL 387 281\nL 390 278\nL 393 274\nL 395 271\nL 398 268\nL 400 264\nL 402 261\nL 404 257\nL 406 253\nL 408 250\nL 409 246\nL 411 242\nL 412 238\nL 413 234\nL 414 230\nL 415 226\nL 416 222\nL 417 218\nL 417 214\nL 418 210\nL 418 206\nL 418 202\nL 418 198\nL 418 194\nL 417 190\nL 417 186\nL 416 182\nL 415 178\nL 414 174\nL 413 170\nL 412 166\nL 411 162\nL 409 158\nL 408 154\nL 406 151\nL 404 147\nL 402 143\nL 400 140\nL 398 136\nL 395 133\nL 393 130\nL 390 126\nL 387 123\nL 385 120\nL 382 117\nL 379 115\nL 376 112\nL 372 109\nL 369 107\nL 366 104\nL 362 102\nL 359 100\nL 355 98\nL 351 96\nL 348 94\nL 344 93\nL 340 91\nL 336 90\nL 332 89\nL 328 88\nL 324 87\nL 320 86\nL 316 85\nL 312 85\nL 308 84\nL 304 84\nL 300 84\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 300 68\nA 134 134 259.20 1 1 169 227\nL 170 226\nA 7 7 180.00 0 1 184 224\nL 184 224\nL 185 228\nL 186 232\nL 187 236\nL 188 240\nL 190 243\nL 191 247\nL 193 251\nL 195 255\nL 197 258\nL 199 262\nL 201 266\nL 203 269\nL 206 272\nL 208 276\nL 211 279\nL 214 282\nL 216 285\nL 219 288\nL 222 290\nL 225 293\nL 229 296\nL 232 298\nL 235 300\nL 239 303\nL 242 305\nL 246 307\nL 250 308\nL 254 310\nL 257 312\nL 261 313\nL 265 314\nL 269 316\nL 273 317\nL 277 318\nL 281 318\nL 285 319\nL 289 319\nL 293 320\nL 298 320\nL 301 320\nL 305 320\nL 309 319\nL 313 319\nL 317 319\nL 321 318\nL 325 317\nL 329 316\nL 333 315\nL 337 314\nL 341 312\nL 345 311\nL 349 309\nL 352 307\nL 356 306\nL 360 304\nL 363 301\nL 366 299\nL 370 297\nL 373 294\nL 376 292\nL 379 289\nL 382 286\nL 385 283\nL 388 280\nL 391 277\nL 393 274\nL 396 270\nL 398 267\nL 400 264\nL 402 260\nL 404 256\nL 406 253\nL 408 249\nL 410 245\nL 411 241\nL 412 237\nL 413 233\nL 415 229\nL 415 225\nL 416 221\nL 417 217\nL 417 213\nL 418 209\nL 418 205\nL 418 202\nL 418 198\nL 418 194\nL 417 190\nL 417 186\nL 416 181\nL 415 177\nL 414 173\nL 413 169\nL 412 165\nL 411 162\nL 409 158\nL 407 154\nL 406 150\nL 404 147\nL 402 143\nL 400 139\nL 397 136\nL 395 133\nL 393 129\nL 390 126\nL 387 123\nL 384 120\nL 382 117\nL 379 114\nL 375 112\nL 372 109\nL 369 107\nL 366 104\nL 362 102\nL 359 100\nL 355 98\nL 351 96\nL 348 94\nL 344 93\nL 340 91\nL 336 90\nL 332 89\nL 328 88\nL 324 87\nL 320 86\nL 316 85\nL 312 85\nL 308 84\nL 304 84\nL 300 84\nL 300 83\nA 7 7 180.00 0 1 300 69\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><text x=\"236\" y=\"82\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">CPU 72%</text><path  d=\"M 300 91\nA 111 111 180.00 0 1 300 313\nL 300 313\nA 111 111 180.00 0 1 300 91\nL 300 106\nL 297 107\nL 294 107\nL 290 107\nL 287 107\nL 284 108\nL 281 109\nL 277 109\nL 274 110\nL 271 111\nL 268 112\nL 265 113\nL 261 115\nL 258 116\nL 255 118\nL 252 119\nL 250 121\nL 247 123\nL 244 125\nL 241 127\nL 239 129\nL 236 131\nL 234 133\nL 231 136\nL 229 138\nL 227 141\nL 225 143\nL 223 146\nL 221 149\nL 219 152\nL 217 154\nL 216 157\nL 214 160\nL 213 163\nL 211 167\nL 210 170\nL 209 173\nL 208 176\nL 207 179\nL 207 183\nL 206 186\nL 205 189\nL 205 192\nL 205 196\nL 205 199\nL 204 202\nL 205 205\nL 205 208\nL 205 212\nL 205 215\nL 206 218\nL 207 221\nL 207 225\nL 208 228\nL 209 231\nL 210 234\nL 211 237\nL 213 241\nL 214 244\nL 216 247\nL 217 250\nL 219 252\nL 221 255\nL 223 258\nL 225 261\nL 227 263\nL 229 266\nL 231 268\nL 234 271\nL 236 273\nL 239 275\nL 241 277\nL 244 279\nL 247 281\nL 250 283\nL 252 285\nL 255 286\nL 258 288\nL 261 289\nL 265 291\nL 268 292\nL 271 293\nL 274 294\nL 277 295\nL 281 295\nL 284 296\nL 287 297\nL 290 297\nL 294 297\nL 297 297\nL 300 298\nL 303 297\nL 306 297\nL 310 297\nL 313 297\nL 316 296\nL 319 295\nL 323 295\nL 326 294\nL 329 293\nL 332 292\nL 335 291\nL 339 289\nL 342 288\nL 345 286\nL 348 285\nL 350 283\nL 353 281\nL 356 279\nL 359 277\nL 361 275\nL 364 273\nL 366 271\nL 369 268\nL 371 266\nL 373 263\nL 375 261\nL 377 258\nL 379 255\nL 381 252\nL 383 250\nL 384 247\nL 386 244\nL 387 241\nL 389 237\nL 390 234\nL 391 231\nL 392 228\nL 393 225\nL 393 221\nL 394 218\nL 395 215\nL 395 212\nL 395 208\nL 395 205\nL 396 202\nL 395 199\nL 395 196\nL 395 192\nL 395 189\nL 394 186\nL 393 183\nL 393 179\nL 392 176\nL 391 173\nL 390 170\nL 389 167\nL 387 163\nL 386 160\nL 384 157\nL 383 154\nL 381 152\nL 379 149\nL 377 146\nL 375 143\nL 373 141\nL 371 138\nL 369 136\nL 366 133\nL 364 131\nL 361 129\nL 359 127\nL 356 125\nL 353 123\nL 350 121\nL 348 119\nL 345 118\nL 342 116\nL 339 115\nL 335 113\nL 332 112\nL 329 111\nL 326 110\nL 323 109\nL 319 109\nL 316 108\nL 313 107\nL 310 107\nL 306 107\nL 303 107\nL 300 106\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 300 91\nA 111 111 162.00 0 1 334 308\nL 334 307\nA 7 7 180.00 0 1 330 293\nL 329 293\nL 332 292\nL 335 291\nL 339 289\nL 342 288\nL 345 286\nL 348 285\nL 350 283\nL 353 281\nL 356 279\nL 359 277\nL 361 275\nL 364 273\nL 366 271\nL 369 268\nL 371 266\nL 373 263\nL 375 261\nL 377 258\nL 379 255\nL 381 252\nL 383 250\nL 384 247\nL 386 244\nL 387 241\nL 389 237\nL 390 234\nL 391 231\nL 392 228\nL 393 225\nL 393 221\nL 394 218\nL 395 215\nL 395 212\nL 395 208\nL 395 205\nL 396 202\nL 395 199\nL 395 196\nL 395 192\nL 395 189\nL 394 186\nL 393 183\nL 393 179\nL 392 176\nL 391 173\nL 390 170\nL 389 167\nL 387 163\nL 386 160\nL 384 157\nL 383 154\nL 381 152\nL 379 149\nL 377 146\nL 375 143\nL 373 141\nL 371 138\nL 369 136\nL 366 133\nL 364 131\nL 361 129\nL 359 127\nL 356 125\nL 353 123\nL 350 121\nL 348 119\nL 345 118\nL 342 116\nL 339 115\nL 335 113\nL 332 112\nL 329 111\nL 326 110\nL 323 109\nL 319 109\nL 316 108\nL 313 107\nL 310 107\nL 306 107\nL 303 107\nL 300 106\nL 300 106\nA 7 7 180.00 0 1 300 92\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><text x=\"213\" y=\"105\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Memory 45%</text><path  d=\"M 300 113\nA 89 89 180.00 0 1 300 291\nL 300 291\nA 89 89 180.00 0 1 300 113\nL 300 129\nL 298 129\nL 295 129\nL 293 129\nL 290 130\nL 288 130\nL 285 130\nL 283 131\nL 280 132\nL 278 132\nL 275 133\nL 273 134\nL 271 135\nL 268 136\nL 266 137\nL 264 139\nL 261 140\nL 259 141\nL 257 143\nL 255 144\nL 253 146\nL 251 148\nL 249 149\nL 247 151\nL 246 153\nL 244 155\nL 242 157\nL 241 159\nL 239 161\nL 238 163\nL 237 166\nL 235 168\nL 234 170\nL 233 173\nL 232 175\nL 231 177\nL 230 180\nL 230 182\nL 229 185\nL 228 187\nL 228 190\nL 228 192\nL 227 195\nL 227 197\nL 227 200\nL 227 202\nL 227 204\nL 227 207\nL 227 209\nL 228 212\nL 228 214\nL 228 217\nL 229 219\nL 230 222\nL 230 224\nL 231 227\nL 232 229\nL 233 231\nL 234 234\nL 235 236\nL 237 238\nL 238 241\nL 239 243\nL 241 245\nL 242 247\nL 244 249\nL 246 251\nL 247 253\nL 249 255\nL 251 256\nL 253 258\nL 255 260\nL 257 261\nL 259 263\nL 261 264\nL 264 265\nL 266 267\nL 268 268\nL 271 269\nL 273 270\nL 275 271\nL 278 272\nL 280 272\nL 283 273\nL 285 274\nL 288 274\nL 290 274\nL 293 275\nL 295 275\nL 298 275\nL 300 275\nL 302 275\nL 305 275\nL 307 275\nL 310 274\nL 312 274\nL 315 274\nL 317 273\nL 320 272\nL 322 272\nL 325 271\nL 327 270\nL 329 269\nL 332 268\nL 334 267\nL 336 265\nL 339 264\nL 341 263\nL 343 261\nL 345 260\nL 347 258\nL 349 256\nL 351 255\nL 353 253\nL 354 251\nL 356 249\nL 358 247\nL 359 245\nL 361 243\nL 362 241\nL 363 238\nL 365 236\nL 366 234\nL 367 231\nL 368 229\nL 369 227\nL 370 224\nL 370 222\nL 371 219\nL 372 217\nL 372 214\nL 372 212\nL 373 209\nL 373 207\nL 373 204\nL 373 202\nL 373 200\nL 373 197\nL 373 195\nL 372 192\nL 372 190\nL 372 187\nL 371 185\nL 370 182\nL 370 180\nL 369 177\nL 368 175\nL 367 173\nL 366 170\nL 365 168\nL 363 166\nL 362 163\nL 361 161\nL 359 159\nL 358 157\nL 356 155\nL 354 153\nL 353 151\nL 351 149\nL 349 148\nL 347 146\nL 345 144\nL 343 143\nL 341 141\nL 339 140\nL 336 139\nL 334 137\nL 332 136\nL 329 135\nL 327 134\nL 325 133\nL 322 132\nL 320 132\nL 317 131\nL 315 130\nL 312 130\nL 310 130\nL 307 129\nL 305 129\nL 302 129\nL 300 129\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(224,230,242,1.0)\"/><path  d=\"M 300 113\nA 89 89 327.60 1 1 253 127\nL 253 128\nA 7 7 180.00 0 1 261 140\nL 261 140\nL 259 142\nL 257 143\nL 255 145\nL 253 146\nL 251 148\nL 249 150\nL 247 152\nL 245 154\nL 244 156\nL 242 158\nL 241 160\nL 239 162\nL 238 164\nL 236 166\nL 235 168\nL 234 171\nL 233 173\nL 232 175\nL 231 178\nL 230 180\nL 230 183\nL 229 185\nL 228 188\nL 228 190\nL 227 193\nL 227 195\nL 227 198\nL 227 200\nL 227 202\nL 227 204\nL 227 207\nL 227 210\nL 228 212\nL 228 215\nL 228 217\nL 229 220\nL 230 222\nL 231 225\nL 231 227\nL 232 229\nL 233 232\nL 234 234\nL 236 236\nL 237 239\nL 238 241\nL 240 243\nL 241 245\nL 243 247\nL 244 249\nL 246 251\nL 248 253\nL 250 255\nL 251 257\nL 253 258\nL 255 260\nL 257 261\nL 260 263\nL 262 264\nL 264 265\nL 266 267\nL 268 268\nL 271 269\nL 273 270\nL 276 271\nL 278 272\nL 280 272\nL 283 273\nL 285 274\nL 288 274\nL 291 275\nL 293 275\nL 296 275\nL 298 275\nL 300 275\nL 302 275\nL 305 275\nL 307 275\nL 310 274\nL 313 274\nL 315 274\nL 318 273\nL 320 272\nL 323 272\nL 325 271\nL 327 270\nL 330 269\nL 332 268\nL 334 266\nL 337 265\nL 339 264\nL 341 262\nL 343 261\nL 345 259\nL 347 258\nL 349 256\nL 351 254\nL 353 253\nL 354 251\nL 356 249\nL 358 247\nL 359 245\nL 361 243\nL 362 240\nL 363 238\nL 365 236\nL 366 234\nL 367 231\nL 368 229\nL 369 227\nL 370 224\nL 370 222\nL 371 219\nL 372 217\nL 372 214\nL 373 212\nL 373 209\nL 373 206\nL 373 204\nL 373 202\nL 373 200\nL 373 197\nL 373 195\nL 372 192\nL 372 190\nL 372 187\nL 371 185\nL 370 182\nL 370 180\nL 369 177\nL 368 175\nL 367 172\nL 366 170\nL 365 168\nL 363 166\nL 362 163\nL 361 161\nL 359 159\nL 358 157\nL 356 155\nL 354 153\nL 352 151\nL 351 149\nL 349 148\nL 347 146\nL 345 144\nL 343 143\nL 341 141\nL 339 140\nL 336 139\nL 334 137\nL 332 136\nL 329 135\nL 327 134\nL 325 133\nL 322 132\nL 320 132\nL 317 131\nL 315 130\nL 312 130\nL 310 130\nL 307 129\nL 305 129\nL 302 129\nL 300 129\nL 300 128\nA 7 7 180.00 0 1 300 114\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(250,200,88,1.0)\"/><text x=\"236\" y=\"127\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">Disk 91%</text></svg>",
+		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				seriesList := NewRadialBarSeriesList([]float64{
+					7.2,
+					3,
+				}, []float64{
+					10,
+					4,
+				})
+				seriesList[1].Label.Formatter = "{c}/4"
+				_, err := NewRadialBarChart(p, RadialBarChartOption{
+					SeriesList: seriesList,
+					RadialBar: RadialBarOption{
+						StartAngle: 90,
+						TrackColor: Color{
+							R: 220,
+							G: 220,
+							B: 220,
+							A: 255,
+						},
+					},
+					BarWidth: 20,
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 448 185\nA 148 148 180.00 0 1 152 185\nL 152 185\nA 148 148 180.00 0 1 448 185\nL 428 185\nL 427 181\nL 427 177\nL 427 172\nL 426 168\nL 426 163\nL 425 159\nL 424 155\nL 423 150\nL 421 146\nL 420 142\nL 418 138\nL 416 133\nL 415 129\nL 413 125\nL 410 121\nL 408 118\nL 406 114\nL 403 110\nL 400 107\nL 398 103\nL 395 100\nL 392 97\nL 388 93\nL 385 90\nL 382 87\nL 378 85\nL 375 82\nL 371 79\nL 367 77\nL 364 75\nL 360 72\nL 356 70\nL 352 69\nL 347 67\nL 343 65\nL 339 64\nL 335 62\nL 330 61\nL 326 60\nL 322 59\nL 317 59\nL 313 58\nL 308 58\nL 304 58\nL 300 57\nL 296 58\nL 292 58\nL 287 58\nL 283 59\nL 278 59\nL 274 60\nL 270 61\nL 265 62\nL 261 64\nL 257 65\nL 253 67\nL 248 69\nL 244 70\nL 240 72\nL 236 75\nL 233 77\nL 229 79\nL 225 82\nL 222 85\nL 218 87\nL 215 90\nL 212 93\nL 208 97\nL 205 100\nL 202 103\nL 200 107\nL 197 110\nL 194 114\nL 192 118\nL 190 121\nL 187 125\nL 185 129\nL 184 133\nL 182 138\nL 180 142\nL 179 146\nL 177 150\nL 176 155\nL 175 159\nL 174 163\nL 174 168\nL 173 172\nL 173 177\nL 173 181\nL 172 185\nL 173 189\nL 173 193\nL 173 198\nL 174 202\nL 174 207\nL 175 211\nL 176 215\nL 177 220\nL 179 224\nL 180 228\nL 182 232\nL 184 237\nL 185 241\nL 187 245\nL 190 248\nL 192 252\nL 194 256\nL 197 260\nL 200 263\nL 202 267\nL 205 270\nL 208 273\nL 212 277\nL 215 280\nL 218 283\nL 222 285\nL 225 288\nL 229 291\nL 233 293\nL 237 295\nL 240 298\nL 244 300\nL 248 301\nL 253 303\nL 257 305\nL 261 306\nL 265 308\nL 270 309\nL 274 310\nL 278 311\nL 283 311\nL 287 312\nL 292 312\nL 296 312\nL 300 313\nL 304 312\nL 308 312\nL 313 312\nL 317 311\nL 322 311\nL 326 310\nL 330 309\nL 335 308\nL 339 306\nL 343 305\nL 347 303\nL 352 301\nL 356 300\nL 360 298\nL 364 295\nL 367 293\nL 371 291\nL 375 288\nL 378 285\nL 382 283\nL 385 280\nL 388 277\nL 392 273\nL 395 270\nL 398 267\nL 400 263\nL 403 260\nL 406 256\nL 408 252\nL 410 248\nL 413 245\nL 415 241\nL 416 237\nL 418 232\nL 420 228\nL 421 224\nL 423 220\nL 424 215\nL 425 211\nL 426 207\nL 426 202\nL 427 198\nL 427 193\nL 427 189\nL 428 185\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(220,220,220,1.0)\"/><path  d=\"M 448 185\nA 148 148 259.20 1 1 273 40\nL 277 60\nL 272 61\nL 268 62\nL 264 63\nL 259 64\nL 255 66\nL 251 67\nL 247 69\nL 243 71\nL 239 73\nL 235 75\nL 231 78\nL 228 80\nL 224 83\nL 220 86\nL 217 88\nL 214 91\nL 210 95\nL 207 98\nL 204 101\nL 201 104\nL 199 108\nL 196 111\nL 194 115\nL 191 119\nL 189 123\nL 187 127\nL 185 131\nL 183 135\nL 181 139\nL 180 143\nL 178 147\nL 177 152\nL 176 156\nL 175 160\nL 174 165\nL 174 169\nL 173 173\nL 173 178\nL 173 182\nL 173 186\nL 173 190\nL 173 195\nL 173 199\nL 174 203\nL 175 208\nL 176 212\nL 177 217\nL 178 221\nL 179 225\nL 181 229\nL 182 233\nL 184 238\nL 186 242\nL 188 246\nL 190 249\nL 192 253\nL 195 257\nL 198 261\nL 200 264\nL 203 268\nL 206 271\nL 209 274\nL 212 277\nL 216 280\nL 219 283\nL 222 286\nL 226 289\nL 230 291\nL 233 293\nL 237 296\nL 241 298\nL 245 300\nL 249 302\nL 253 303\nL 257 305\nL 262 306\nL 266 308\nL 270 309\nL 275 310\nL 279 311\nL 283 311\nL 288 312\nL 292 312\nL 297 312\nL 300 312\nL 305 312\nL 309 312\nL 313 312\nL 318 311\nL 322 310\nL 327 310\nL 331 309\nL 335 307\nL 340 306\nL 344 305\nL 348 303\nL 352 301\nL 356 299\nL 360 297\nL 364 295\nL 368 293\nL 371 290\nL 375 288\nL 379 285\nL 382 282\nL 385 279\nL 389 276\nL 392 273\nL 395 270\nL 398 267\nL 401 263\nL 403 260\nL 406 256\nL 408 252\nL 410 248\nL 413 244\nL 415 240\nL 417 236\nL 418 232\nL 420 228\nL 421 224\nL 423 220\nL 424 215\nL 425 211\nL 426 207\nL 426 202\nL 427 198\nL 427 193\nL 427 189\nL 428 185\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><text x=\"432\" y=\"156\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(90.00,432,156)\">72%</text><path  d=\"M 419 185\nA 119 119 180.00 0 1 181 185\nL 181 185\nA 119 119 180.00 0 1 419 185\nL 399 185\nL 399 182\nL 399 179\nL 398 175\nL 398 172\nL 397 168\nL 397 165\nL 396 161\nL 395 158\nL 394 155\nL 393 151\nL 392 148\nL 390 145\nL 389 142\nL 387 139\nL 386 136\nL 384 133\nL 382 130\nL 380 127\nL 378 124\nL 376 122\nL 373 119\nL 371 116\nL 369 114\nL 366 112\nL 363 109\nL 361 107\nL 358 105\nL 355 103\nL 352 101\nL 349 99\nL 346 98\nL 343 96\nL 340 95\nL 337 93\nL 334 92\nL 330 91\nL 327 90\nL 324 89\nL 320 88\nL 317 88\nL 313 87\nL 310 87\nL 306 86\nL 303 86\nL 300 86\nL 297 86\nL 294 86\nL 290 87\nL 287 87\nL 283 88\nL 280 88\nL 276 89\nL 273 90\nL 270 91\nL 266 92\nL 263 93\nL 260 95\nL 257 96\nL 254 98\nL 251 99\nL 248 101\nL 245 103\nL 242 105\nL 239 107\nL 237 109\nL 234 112\nL 231 114\nL 229 116\nL 227 119\nL 224 122\nL 222 124\nL 220 127\nL 218 130\nL 216 133\nL 214 136\nL 213 139\nL 211 142\nL 210 145\nL 208 148\nL 207 151\nL 206 155\nL 205 158\nL 204 161\nL 203 165\nL 203 168\nL 202 172\nL 202 175\nL 201 179\nL 201 182\nL 201 185\nL 201 188\nL 201 191\nL 202 195\nL 202 198\nL 203 202\nL 203 205\nL 204 209\nL 205 212\nL 206 215\nL 207 219\nL 208 222\nL 210 225\nL 211 228\nL 213 231\nL 214 234\nL 216 237\nL 218 240\nL 220 243\nL 222 246\nL 224 248\nL 227 251\nL 229 254\nL 231 256\nL 234 258\nL 237 261\nL 239 263\nL 242 265\nL 245 267\nL 248 269\nL 251 271\nL 254 272\nL 257 274\nL 260 275\nL 263 277\nL 266 278\nL 270 279\nL 273 280\nL 276 281\nL 280 282\nL 283 282\nL 287 283\nL 290 283\nL 294 284\nL 297 284\nL 300 284\nL 303 284\nL 306 284\nL 310 283\nL 313 283\nL 317 282\nL 320 282\nL 324 281\nL 327 280\nL 330 279\nL 334 278\nL 337 277\nL 340 275\nL 343 274\nL 346 272\nL 349 271\nL 352 269\nL 355 267\nL 358 265\nL 361 263\nL 363 261\nL 366 258\nL 369 256\nL 371 254\nL 373 251\nL 376 248\nL 378 246\nL 380 243\nL 382 240\nL 384 237\nL 386 234\nL 387 231\nL 389 228\nL 390 225\nL 392 222\nL 393 219\nL 394 215\nL 395 212\nL 396 209\nL 397 205\nL 397 202\nL 398 198\nL 398 195\nL 399 191\nL 399 188\nL 399 185\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(220,220,220,1.0)\"/><path  d=\"M 419 185\nA 119 119 270.00 1 1 300 66\nL 300 86\nL 297 86\nL 294 86\nL 290 87\nL 287 87\nL 283 88\nL 280 88\nL 276 89\nL 273 90\nL 270 91\nL 266 92\nL 263 93\nL 260 95\nL 257 96\nL 254 98\nL 251 99\nL 248 101\nL 245 103\nL 242 105\nL 239 107\nL 237 109\nL 234 112\nL 231 114\nL 229 116\nL 227 119\nL 224 122\nL 222 124\nL 220 127\nL 218 130\nL 216 133\nL 214 136\nL 213 139\nL 211 142\nL 210 145\nL 208 148\nL 207 151\nL 206 155\nL 205 158\nL 204 161\nL 203 165\nL 203 168\nL 202 172\nL 202 175\nL 201 179\nL 201 182\nL 201 185\nL 201 188\nL 201 191\nL 202 195\nL 202 198\nL 203 202\nL 203 205\nL 204 209\nL 205 212\nL 206 215\nL 207 219\nL 208 222\nL 210 225\nL 211 228\nL 213 231\nL 214 234\nL 216 237\nL 218 240\nL 220 243\nL 222 246\nL 224 248\nL 227 251\nL 229 254\nL 231 256\nL 234 258\nL 237 261\nL 239 263\nL 242 265\nL 245 267\nL 248 269\nL 251 271\nL 254 272\nL 257 274\nL 260 275\nL 263 277\nL 266 278\nL 270 279\nL 273 280\nL 276 281\nL 280 282\nL 283 282\nL 287 283\nL 290 283\nL 294 284\nL 297 284\nL 300 284\nL 303 284\nL 306 284\nL 310 283\nL 313 283\nL 317 282\nL 320 282\nL 324 281\nL 327 280\nL 330 279\nL 334 278\nL 337 277\nL 340 275\nL 343 274\nL 346 272\nL 349 271\nL 352 269\nL 355 267\nL 358 265\nL 361 263\nL 363 261\nL 366 258\nL 369 256\nL 371 254\nL 373 251\nL 376 248\nL 378 246\nL 380 243\nL 382 240\nL 384 237\nL 386 234\nL 387 231\nL 389 228\nL 390 225\nL 392 222\nL 393 219\nL 394 215\nL 395 212\nL 396 209\nL 397 205\nL 397 202\nL 398 198\nL 398 195\nL 399 191\nL 399 188\nL 399 185\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,1.0)\"/><text x=\"403\" y=\"160\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(90.00,403,160)\">3/4</text></svg>",
+		},
+	}
+
+	for _, tt := range tests {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		data, err := tt.render(p)
+		assert.Nil(err)
+		assert.Equal(tt.result, string(data))
+	}
+}
+
+func TestRadialBarChartNullValue(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := NewPainter(PainterOptions{
+		Type:   ChartOutputSVG,
+		Width:  600,
+		Height: 400,
+	}, PainterThemeOption(defaultTheme))
+	assert.Nil(err)
+	seriesList := NewRadialBarSeriesList([]float64{
+		nullValue,
+		45,
+	}, nil)
+	seriesList[0].Name = "CPU"
+	seriesList[1].Name = "Memory"
+	_, err = NewRadialBarChart(p, RadialBarChartOption{
+		SeriesList: seriesList,
+	}).Render()
+	assert.Nil(err)
+	data, err := p.Bytes()
+	assert.Nil(err)
+	// 背景、两个轨道以及一个圆环，空值不展示圆环与文本
+	assert.Equal(4, strings.Count(string(data), "<path"))
+	assert.NotContains(string(data), "CPU")
+	assert.Contains(string(data), "Memory 45%")
+}
+
+func TestRadialBarChartLabelShow(t *testing.T) {
+	assert := assert.New(t)
+
+	render := func(labelShow *bool) string {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		_, err = NewRadialBarChart(p, RadialBarChartOption{
+			SeriesList: NewRadialBarSeriesList([]float64{
+				72,
+				45,
+			}, nil),
+			RadialBar: RadialBarOption{
+				LabelShow: labelShow,
+			},
+		}).Render()
+		assert.Nil(err)
+		data, err := p.Bytes()
+		assert.Nil(err)
+		return string(data)
+	}
+	assert.Equal(2, strings.Count(render(nil), "<text"))
+	assert.Equal(0, strings.Count(render(FalseFlag()), "<text"))
+	assert.Equal(2, strings.Count(render(TrueFlag()), "<text"))
+}
+
+func TestRadialBarChartZeroInnerRadius(t *testing.T) {
+	assert := assert.New(t)
+
+	render := func(innerRadius string) string {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		_, err = NewRadialBarChart(p, RadialBarChartOption{
+			SeriesList: NewRadialBarSeriesList([]float64{
+				72,
+				45,
+				91,
+			}, nil),
+			RadialBar: RadialBarOption{
+				Radius:      "45%",
+				InnerRadius: innerRadius,
+			},
+		}).Render()
+		assert.Nil(err)
+		data, err := p.Bytes()
+		assert.Nil(err)
+		return string(data)
+	}
+	// 内半径为0时，圆环的间距按整个半径计算
+	assert.Equal(render("0"), render("0%"))
+	assert.NotEqual(render(""), render("0%"))
+	assert.NotEqual(render("40%"), render("0%"))
+}
@@ -24,9 +24,11 @@ package charts
 
 import (
 	"math"
+	"strconv"
 )
 
 const defaultAxisDivideCount = 6
+const defaultPercentAxisDivideCount = 5
 
 type axisRange struct {
 	p           *Painter
@@ -35,6 +37,8 @@ type axisRange struct {
 	max         float64
 	size        int
 	boundary    bool
+	// The base of logarithmic axis, it's linear axis if it's 0
+	logBase float64
 }
 
 type AxisRangeOption struct {
@@ -47,60 +51,249 @@ type AxisRangeOption struct {
 	Size int
 	// Boundary gap
 	Boundary bool
-	// The count of divide
+	// The count of divide, it serves only as a recommendation,
+	// the count is adjusted to make ticks nice
 	DivideCount int
+	// The base of logarithmic axis, e.g.: 10.
+	// The range is linear if it's 0
+	LogBase float64
+	// The fixed min value of axis, the range starts at it if it's set
+	FixedMin *float64
+	// The fixed max value of axis, the range ends at it if it's set
+	FixedMax *float64
 }
 
 // NewRange returns a axis range
 func NewRange(opt AxisRangeOption) axisRange {
-	max := opt.Max
+	if opt.LogBase > 0 {
+		return newLogRange(opt)
+	}
+	divideCount := opt.DivideCount
+	if divideCount <= 0 {
+		divideCount = defaultAxisDivideCount
+	}
 	min := opt.Min
+	max := opt.Max
+	if opt.FixedMin != nil {
+		min = *opt.FixedMin
+	}
+	if opt.FixedMax != nil {
+		max = *opt.FixedMax
+	}
+	niceMin, niceMax, interval := NiceRange(min, max, divideCount)
+	// 指定最小最大值时，以其为边界
+	switch {
+	case opt.FixedMin != nil && opt.FixedMax != nil:
+		interval = (max - min) / float64(divideCount)
+	case opt.FixedMin != nil:
+		max = min + math.Ceil((max-min)/interval-niceEpsilon)*interval
+	case opt.FixedMax != nil:
+		min = max - math.Ceil((max-min)/interval-niceEpsilon)*interval
+	default:
+		min = niceMin
+		max = niceMax
+	}
+	if interval > 0 {
+		divideCount = int(math.Round((max - min) / interval))
+	}
+	if divideCount <= 0 {
+		divideCount = 1
+	}
+	return axisRange{
+		p:           opt.Painter,
+		divideCount: divideCount,
+		min:         min,
+		max:         max,
+		size:        opt.Size,
+		boundary:    opt.Boundary,
+	}
+}
 
-	max += math.Abs(max * 0.1)
-	min -= math.Abs(min * 0.1)
-	divideCount := opt.DivideCount
-	r := math.Abs(max - min)
+// newCategoryRange returns a range which is divided equally by the count of category
+func newCategoryRange(p *Painter, divideCount, size int) axisRange {
+	return axisRange{
+		p:           p,
+		divideCount: divideCount,
+		size:        size,
+	}
+}
 
-	// 最小单位计算
-	unit := 1
-	if r > 5 {
-		unit = 2
+// 浮点数计算误差
+const niceEpsilon = 1e-9
+
+// NiceRange returns the nice min, max and interval of range,
+// the interval is 1, 2 or 5 multiplied by a power of 10
+// and the count of intervals is the closest to the split number
+func NiceRange(min, max float64, splitNumber int) (float64, float64, float64) {
+	if splitNumber <= 0 {
+		splitNumber = defaultAxisDivideCount
 	}
-	if r > 10 {
-		unit = 4
+	if min > max {
+		min, max = max, min
 	}
-	if r > 30 {
-		unit = 5
+	span := max - min
+	// 无数据时，使用默认的范围
+	if math.IsInf(span, 0) || math.IsNaN(span) {
+		min = 0
+		max = 1
+		span = 1
 	}
-	if r > 100 {
-		unit = 10
+	// 只有单一值时，以其量级计算
+	if span == 0 {
+		span = math.Abs(max)
+		if span == 0 {
+			span = 1
+		}
 	}
-	if r > 200 {
-		unit = 20
+	// 四舍五入的间隔可能使分段数远超split number，
+	// 因此尝试1、2、5、10倍的间隔，选择分段数最接近的
+	base := math.Pow(10, math.Floor(math.Log10(span/float64(splitNumber))))
+	var niceMin, niceMax, interval float64
+	minDiff := math.MaxFloat64
+	for _, f := range []float64{
+		1,
+		2,
+		5,
+		10,
+	} {
+		currentInterval := f * base
+		currentMin := math.Floor(min/currentInterval+niceEpsilon) * currentInterval
+		currentMax := math.Ceil(max/currentInterval-niceEpsilon) * currentInterval
+		if currentMax <= currentMin {
+			currentMax = currentMin + currentInterval
+		}
+		count := math.Round((currentMax - currentMin) / currentInterval)
+		diff := math.Abs(count - float64(splitNumber))
+		// 相同时选择较大的间隔
+		if diff <= minDiff {
+			minDiff = diff
+			niceMin = currentMin
+			niceMax = currentMax
+			interval = currentInterval
+		}
 	}
-	unit = int((r/float64(divideCount))/float64(unit))*unit + unit
+	return niceMin, niceMax, interval
+}
 
-	if min != 0 {
-		isLessThanZero := min < 0
-		min = float64(int(min/float64(unit)) * unit)
-		// 如果是小于0，int的时候向上取整了，因此调整
-		if min < 0 ||
-			(isLessThanZero && min == 0) {
-			min -= float64(unit)
+// niceCeilNumber returns the smallest nice number which is >= value,
+// it's 1, 2, 2.5 or 5 multiplied by a power of 10
+func niceCeilNumber(value float64) float64 {
+	if value <= 0 {
+		return 1
+	}
+	base := math.Pow(10, math.Floor(math.Log10(value)))
+	f := value / base
+	for _, v := range []float64{
+		1,
+		2,
+		2.5,
+		5,
+	} {
+		if f <= v+niceEpsilon {
+			return v * base
 		}
 	}
-	max = min + float64(unit*divideCount)
-	expectMax := opt.Max * 2
-	if max > expectMax {
-		max = float64(ceilFloatToInt(expectMax))
+	return 10 * base
+}
+
+// niceNumber returns the nearest nice number of value,
+// which is 1, 2 or 5 multiplied by a power of 10
+func niceNumber(value float64) float64 {
+	base := math.Pow(10, math.Floor(math.Log10(value)))
+	f := value / base
+	switch {
+	case f < 1.5:
+		f = 1
+	case f < 3:
+		f = 2
+	case f < 7:
+		f = 5
+	default:
+		f = 10
+	}
+	return f * base
+}
+
+// newLogRange returns a logarithmic axis range,
+// the ticks are the powers of base
+func newLogRange(opt AxisRangeOption) axisRange {
+	base := opt.LogBase
+	min := opt.Min
+	// 非正数无法计算对数，使用1代替
+	if min <= 0 {
+		min = 1
+	}
+	max := math.Max(opt.Max, min)
+	minExp := math.Floor(logWithBase(min, base))
+	maxExp := math.Ceil(logWithBase(max, base))
+	if maxExp <= minExp {
+		maxExp = minExp + 1
+	}
+	divideCount := int(maxExp - minExp)
+	// 刻度过多时，每隔step个幂展示
+	if opt.DivideCount > 0 && divideCount > opt.DivideCount {
+		step := ceilFloatToInt(float64(divideCount) / float64(opt.DivideCount))
+		divideCount = ceilFloatToInt(float64(divideCount) / float64(step))
+		maxExp = minExp + float64(divideCount*step)
 	}
 	return axisRange{
 		p:           opt.Painter,
 		divideCount: divideCount,
-		min:         min,
-		max:         max,
+		min:         math.Pow(base, minExp),
+		max:         math.Pow(base, maxExp),
 		size:        opt.Size,
 		boundary:    opt.Boundary,
+		logBase:     base,
+	}
+}
+
+func logWithBase(value, base float64) float64 {
+	return math.Log(value) / math.Log(base)
+}
+
+// alignDivideCount adjusts the range to be divided into count parts with nice interval,
+// it's used for aligning the ticks of multiple axes
+func (r *axisRange) alignDivideCount(count int, min, max float64, fixedMin, fixedMax bool) {
+	span := max - min
+	if count <= 0 || r.divideCount == count || math.IsInf(span, 0) || math.IsNaN(span) {
+		return
+	}
+	r.divideCount = count
+	if fixedMin && fixedMax {
+		return
+	}
+	interval := niceCeilNumber(span / float64(count))
+	for {
+		start := math.Floor(min/interval+niceEpsilon) * interval
+		if fixedMin {
+			start = min
+		}
+		end := start + float64(count)*interval
+		if fixedMax {
+			end = max
+			start = end - float64(count)*interval
+		}
+		if start <= min+niceEpsilon && end >= max-niceEpsilon {
+			r.min = start
+			r.max = end
+			return
+		}
+		// 使用下一个更大的间隔
+		interval = niceCeilNumber(interval * (1 + niceEpsilon*1000))
+	}
+}
+
+// setPercent sets the range to 0-100% for percent stack,
+// it's -100%-100% if there are negative values
+func (r *axisRange) setPercent(min float64, divideCount int) {
+	r.min = 0
+	if min < 0 {
+		r.min = -100
+	}
+	r.max = 100
+	r.divideCount = divideCount
+	if r.min < 0 {
+		r.divideCount *= 2
 	}
 }
 
@@ -112,18 +305,52 @@ func (r axisRange) Values() []string {
 	if r.p != nil && r.p.valueFormatter != nil {
 		formatter = r.p.valueFormatter
 	}
+	isDefaultFormatter := r.p == nil || r.p.valueFormatter == nil
+	// 间隔小于1时，根据间隔的精度展示
+	decimals := -1
+	if r.logBase == 0 && isDefaultFormatter && math.Abs(offset) < 1 {
+		decimals = getDecimals(offset)
+	}
 	for i := 0; i <= r.divideCount; i++ {
 		v := r.min + float64(i)*offset
-		value := formatter(v)
+		if r.logBase > 0 {
+			v = r.getLogValue(i)
+		}
+		value := ""
+		switch {
+		// 对数轴的小数值使用精确的展示
+		case r.logBase > 0 && v < 1 && isDefaultFormatter:
+			value = strconv.FormatFloat(v, 'g', 6, 64)
+		case decimals >= 0:
+			value = formatFloatWithDecimals(v, decimals)
+		default:
+			value = formatter(v)
+		}
 		values = append(values, value)
 	}
 	return values
 }
 
+// getLogValue returns the value of tick for logarithmic range
+func (r axisRange) getLogValue(index int) float64 {
+	minExp := logWithBase(r.min, r.logBase)
+	maxExp := logWithBase(r.max, r.logBase)
+	exp := minExp + float64(index)*(maxExp-minExp)/float64(r.divideCount)
+	return math.Pow(r.logBase, math.Round(exp))
+}
+
 func (r *axisRange) getHeight(value float64) int {
 	if r.max <= r.min {
 		return 0
 	}
+	if r.logBase > 0 {
+		// 非正数展示在底部
+		if value <= 0 {
+			return 0
+		}
+		v := math.Log(value/r.min) / math.Log(r.max/r.min)
+		return int(v * float64(r.size))
+	}
 	v := (value - r.min) / (r.max - r.min)
 	return int(v * float64(r.size))
 }
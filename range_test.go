@@ -0,0 +1,214 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNiceRange(t *testing.T) {
+	assert := assert.New(t)
+
+	min, max, interval := NiceRange(120, 410, 6)
+	assert.Equal(100.0, min)
+	assert.Equal(450.0, max)
+	assert.Equal(50.0, interval)
+
+	min, max, interval = NiceRange(-3.2, 7.5, 5)
+	assert.Equal(-4.0, min)
+	assert.Equal(8.0, max)
+	assert.Equal(2.0, interval)
+
+	min, max, interval = NiceRange(0.0012, 0.0042, 6)
+	assert.InDelta(0.001, min, 1e-12)
+	assert.InDelta(0.0045, max, 1e-12)
+	assert.Equal(0.0005, interval)
+
+	// 单一值
+	min, max, interval = NiceRange(5, 5, 6)
+	assert.Equal(5.0, min)
+	assert.Equal(6.0, max)
+	assert.Equal(1.0, interval)
+
+	// 四舍五入的间隔为20，分段数为9
+	min, max, interval = NiceRange(0, 175.6, 6)
+	assert.Equal(0.0, min)
+	assert.Equal(200.0, max)
+	assert.Equal(50.0, interval)
+	for _, splitNumber := range []int{
+		3,
+		5,
+		6,
+		10,
+	} {
+		for _, value := range []float64{
+			175.6,
+			1.3,
+			42,
+			980,
+			12345,
+		} {
+			min, max, interval = NiceRange(0, value, splitNumber)
+			count := int(math.Round((max - min) / interval))
+			assert.LessOrEqual(count, 2*splitNumber)
+			assert.GreaterOrEqual(count, splitNumber/2)
+		}
+	}
+}
+
+func TestNewRange(t *testing.T) {
+	assert := assert.New(t)
+
+	r := NewRange(AxisRangeOption{
+		Min:         0,
+		Max:         175.6,
+		Size:        300,
+		DivideCount: 6,
+	})
+	assert.Equal(4, r.divideCount)
+	assert.Equal([]string{
+		"0",
+		"50",
+		"100",
+		"150",
+		"200",
+	}, r.Values())
+	assert.Equal(135, r.getHeight(90))
+
+	r = NewRange(AxisRangeOption{
+		Min:         0.1,
+		Max:         0.9,
+		DivideCount: 4,
+	})
+	assert.Equal([]string{
+		"0.0",
+		"0.2",
+		"0.4",
+		"0.6",
+		"0.8",
+		"1.0",
+	}, r.Values())
+
+	// 指定最小值
+	fixedMin := 3.0
+	r = NewRange(AxisRangeOption{
+		Min:         90,
+		Max:         230,
+		DivideCount: 6,
+		FixedMin:    &fixedMin,
+	})
+	assert.Equal(3.0, r.min)
+	assert.Equal(253.0, r.max)
+	assert.Equal(5, r.divideCount)
+
+	// 指定最小最大值
+	fixedMax := 303.0
+	r = NewRange(AxisRangeOption{
+		Min:         90,
+		Max:         230,
+		DivideCount: 3,
+		FixedMin:    &fixedMin,
+		FixedMax:    &fixedMax,
+	})
+	assert.Equal([]string{
+		"3",
+		"103",
+		"203",
+		"303",
+	}, r.Values())
+}
+
+func TestAxisRangeAlignDivideCount(t *testing.T) {
+	assert := assert.New(t)
+
+	r := NewRange(AxisRangeOption{
+		Min:         2,
+		Max:         20.3,
+		DivideCount: 6,
+	})
+	assert.Equal(5, r.divideCount)
+	r.alignDivideCount(6, 2, 20.3, false, false)
+	assert.Equal(6, r.divideCount)
+	assert.Equal(0.0, r.min)
+	assert.Equal(30.0, r.max)
+
+	assert.Equal(2.5, niceCeilNumber(2.1))
+	assert.Equal(100.0, niceCeilNumber(51))
+	assert.Equal(0.02, niceCeilNumber(0.02))
+}
+
+func TestNewLogRange(t *testing.T) {
+	assert := assert.New(t)
+
+	r := NewRange(AxisRangeOption{
+		Min:         1.2,
+		Max:         30000,
+		Size:        500,
+		DivideCount: 6,
+		LogBase:     10,
+	})
+	assert.Equal(1.0, r.min)
+	assert.Equal(100000.0, r.max)
+	assert.Equal(5, r.divideCount)
+	assert.Equal([]string{
+		"1",
+		"10",
+		"100",
+		"1k",
+		"10k",
+		"100k",
+	}, r.Values())
+	assert.Equal(0, r.getHeight(1))
+	assert.Equal(200, r.getHeight(100))
+	assert.Equal(0, r.getHeight(-1))
+
+	// 刻度过多时按间隔展示
+	r = NewRange(AxisRangeOption{
+		Min:         0.001,
+		Max:         1000000,
+		Size:        500,
+		DivideCount: 3,
+		LogBase:     10,
+	})
+	assert.Equal(3, r.divideCount)
+	assert.Equal([]string{
+		"0.001",
+		"1",
+		"1k",
+		"1M",
+	}, r.Values())
+
+	r = NewRange(AxisRangeOption{
+		Min:         3,
+		Max:         3,
+		DivideCount: 6,
+		LogBase:     2,
+	})
+	assert.Equal([]string{
+		"2",
+		"4",
+	}, r.Values())
+}
@@ -0,0 +1,404 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/golang/freetype/truetype"
+)
+
+type sankeyChart struct {
+	p   *Painter
+	opt *SankeyChartOption
+}
+
+type SankeyNode struct {
+	// The name of node, it should be unique
+	Name string
+	// The color of node, default is the series color of theme
+	Color Color
+}
+
+type SankeyLink struct {
+	// The name of source node
+	Source string
+	// The name of target node
+	Target string
+	// The value of link
+	Value float64
+}
+
+type SankeyOption struct {
+	// The width of node, default is 20
+	NodeWidth int
+	// The gap between the nodes of the same column, default is 8
+	NodeGap int
+}
+
+type SankeyChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The nodes of sankey, the nodes of the same column are laid out in order
+	Nodes []SankeyNode
+	// The links between nodes
+	Links []SankeyLink
+	// The padding of sankey chart
+	Padding Box
+	// The option of title
+	Title TitleOption
+	// The option of sankey
+	Sankey SankeyOption
+	// The font size of label, default is the font size of theme
+	FontSize float64
+	// background is filled
+	backgroundIsFilled bool
+}
+
+// NewSankeyChart returns a sankey chart renderer
+func NewSankeyChart(p *Painter, opt SankeyChartOption) *sankeyChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &sankeyChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+type sankeyRenderNode struct {
+	index  int
+	node   *SankeyNode
+	column int
+	value  float64
+	x      float64
+	y      float64
+	height float64
+	// The links start from the node
+	sourceLinks []*sankeyRenderLink
+	// The links end at the node
+	targetLinks []*sankeyRenderLink
+}
+
+type sankeyRenderLink struct {
+	source *sankeyRenderNode
+	target *sankeyRenderNode
+	value  float64
+	width  float64
+	// The top of link at source node
+	sy float64
+	// The top of link at target node
+	ty float64
+}
+
+type sankeyGraph struct {
+	nodes       []*sankeyRenderNode
+	links       []*sankeyRenderLink
+	columnCount int
+}
+
+// newSankeyGraph returns the graph of sankey, the column of node is the
+// longest path from source node, and the node without out link is aligned right
+func newSankeyGraph(nodes []SankeyNode, links []SankeyLink) (*sankeyGraph, error) {
+	if len(nodes) == 0 {
+		return nil, errors.New("The nodes of sankey should not be empty")
+	}
+	g := &sankeyGraph{}
+	nodeMap := make(map[string]*sankeyRenderNode)
+	for index := range nodes {
+		name := nodes[index].Name
+		if _, ok := nodeMap[name]; ok {
+			return nil, fmt.Errorf("The node of sankey is duplicated: %s", name)
+		}
+		n := &sankeyRenderNode{
+			index: index,
+			node:  &nodes[index],
+		}
+		nodeMap[name] = n
+		g.nodes = append(g.nodes, n)
+	}
+	for _, item := range links {
+		if item.Value <= 0 {
+			continue
+		}
+		source, ok := nodeMap[item.Source]
+		if !ok {
+			return nil, fmt.Errorf("The node of sankey link is not found: %s", item.Source)
+		}
+		target, ok := nodeMap[item.Target]
+		if !ok {
+			return nil, fmt.Errorf("The node of sankey link is not found: %s", item.Target)
+		}
+		link := &sankeyRenderLink{
+			source: source,
+			target: target,
+			value:  item.Value,
+		}
+		source.sourceLinks = append(source.sourceLinks, link)
+		target.targetLinks = append(target.targetLinks, link)
+		g.links = append(g.links, link)
+	}
+
+	// 拓扑排序计算所在列
+	inCounts := make([]int, len(g.nodes))
+	queue := make([]*sankeyRenderNode, 0, len(g.nodes))
+	for index, n := range g.nodes {
+		inCounts[index] = len(n.targetLinks)
+		if inCounts[index] == 0 {
+			queue = append(queue, n)
+		}
+	}
+	count := 0
+	for len(queue) != 0 {
+		n := queue[0]
+		queue = queue[1:]
+		count++
+		for _, link := range n.sourceLinks {
+			target := link.target
+			if n.column+1 > target.column {
+				target.column = n.column + 1
+			}
+			inCounts[target.index]--
+			if inCounts[target.index] == 0 {
+				queue = append(queue, target)
+			}
+		}
+	}
+	if count != len(g.nodes) {
+		return nil, errors.New("The links of sankey should not be cyclic")
+	}
+
+	maxColumn := 0
+	for _, n := range g.nodes {
+		if n.column > maxColumn {
+			maxColumn = n.column
+		}
+	}
+	for _, n := range g.nodes {
+		// 无流出的节点放置于最后一列
+		if len(n.sourceLinks) == 0 && len(n.targetLinks) != 0 {
+			n.column = maxColumn
+		}
+		inValue := 0.0
+		for _, link := range n.targetLinks {
+			inValue += link.value
+		}
+		outValue := 0.0
+		for _, link := range n.sourceLinks {
+			outValue += link.value
+		}
+		n.value = math.Max(inValue, outValue)
+	}
+	g.columnCount = maxColumn + 1
+	return g, nil
+}
+
+// layout computes the position of nodes and links
+func (g *sankeyGraph) layout(width, height, nodeWidth, nodeGap float64) {
+	columns := make([][]*sankeyRenderNode, g.columnCount)
+	for _, n := range g.nodes {
+		if n.value <= 0 {
+			continue
+		}
+		columns[n.column] = append(columns[n.column], n)
+	}
+	// 所有列使用相同的比例
+	ky := math.MaxFloat64
+	for _, nodes := range columns {
+		if len(nodes) == 0 {
+			continue
+		}
+		sum := 0.0
+		for _, n := range nodes {
+			sum += n.value
+		}
+		ky = math.Min(ky, (height-float64(len(nodes)-1)*nodeGap)/sum)
+	}
+	if ky < 0 {
+		ky = 0
+	}
+	columnWidth := 0.0
+	if g.columnCount > 1 {
+		columnWidth = (width - nodeWidth) / float64(g.columnCount-1)
+	}
+	for column, nodes := range columns {
+		columnHeight := float64(len(nodes)-1) * nodeGap
+		for _, n := range nodes {
+			n.height = n.value * ky
+			columnHeight += n.height
+		}
+		// 每列按节点的顺序排列并垂直居中
+		y := (height - columnHeight) / 2
+		for _, n := range nodes {
+			n.x = float64(column) * columnWidth
+			n.y = y
+			y += n.height + nodeGap
+		}
+	}
+	for _, link := range g.links {
+		link.width = link.value * ky
+	}
+	for _, n := range g.nodes {
+		// 连线按另一端节点的位置排序，减少交叉
+		sort.SliceStable(n.sourceLinks, func(i, j int) bool {
+			return n.sourceLinks[i].target.y < n.sourceLinks[j].target.y
+		})
+		sort.SliceStable(n.targetLinks, func(i, j int) bool {
+			return n.targetLinks[i].source.y < n.targetLinks[j].source.y
+		})
+		y := n.y
+		for _, link := range n.sourceLinks {
+			link.sy = y
+			y += link.width
+		}
+		y = n.y
+		for _, link := range n.targetLinks {
+			link.ty = y
+			y += link.width
+		}
+	}
+}
+
+// sankeyCurveTo draws the s curve from (x0, y0) to (x1, y1) by two quad curves
+func sankeyCurveTo(p *Painter, x0, y0, x1, y1 int) {
+	mx := (x0 + x1) >> 1
+	my := (y0 + y1) >> 1
+	p.QuadCurveTo((x0+mx)>>1, y0, mx, my)
+	p.QuadCurveTo((mx+x1)>>1, y1, x1, y1)
+}
+
+func (s *sankeyChart) render(result *defaultRenderResult, nodes []SankeyNode, links []SankeyLink) (Box, error) {
+	opt := s.opt
+	g, err := newSankeyGraph(nodes, links)
+	if err != nil {
+		return BoxZero, err
+	}
+	if len(g.links) == 0 {
+		return BoxZero, errors.New("The links of sankey should not be empty")
+	}
+	nodeWidth := opt.Sankey.NodeWidth
+	if nodeWidth <= 0 {
+		nodeWidth = 20
+	}
+	nodeGap := opt.Sankey.NodeGap
+	if nodeGap <= 0 {
+		nodeGap = 8
+	}
+	if opt.FontSize <= 0 {
+		opt.FontSize = opt.Theme.GetFontSize()
+	}
+	theme := opt.Theme
+	seriesPainter := result.seriesPainter
+	g.layout(float64(seriesPainter.Width()), float64(seriesPainter.Height()), float64(nodeWidth), float64(nodeGap))
+
+	colors := make([]Color, len(g.nodes))
+	for index, n := range g.nodes {
+		colors[index] = theme.GetSeriesColor(index)
+		if !n.node.Color.IsZero() {
+			colors[index] = n.node.Color
+		}
+	}
+
+	// 连线使用源节点的颜色半透明填充
+	for _, link := range g.links {
+		x0 := int(math.Round(link.source.x)) + nodeWidth
+		x1 := int(math.Round(link.target.x))
+		sy0 := int(math.Round(link.sy))
+		sy1 := int(math.Round(link.sy + link.width))
+		ty0 := int(math.Round(link.ty))
+		ty1 := int(math.Round(link.ty + link.width))
+		seriesPainter.SetDrawingStyle(Style{
+			FillColor: colors[link.source.index].WithAlpha(100),
+		})
+		seriesPainter.MoveTo(x0, sy0)
+		sankeyCurveTo(seriesPainter, x0, sy0, x1, ty0)
+		seriesPainter.LineTo(x1, ty1)
+		sankeyCurveTo(seriesPainter, x1, ty1, x0, sy1)
+		seriesPainter.Close()
+		seriesPainter.Fill()
+	}
+
+	seriesPainter.OverrideTextStyle(Style{
+		FontColor: theme.GetTextColor(),
+		FontSize:  opt.FontSize,
+		Font:      opt.Font,
+	})
+	labelMargin := 5
+	for _, n := range g.nodes {
+		if n.value <= 0 {
+			continue
+		}
+		box := Box{
+			Left:   int(math.Round(n.x)),
+			Top:    int(math.Round(n.y)),
+			Right:  int(math.Round(n.x)) + nodeWidth,
+			Bottom: int(math.Round(n.y + n.height)),
+		}
+		seriesPainter.OverrideDrawingStyle(Style{
+			StrokeWidth: 1,
+			StrokeColor: colors[n.index],
+			FillColor:   colors[n.index],
+		}).Rect(box)
+
+		// 最后一列的名称展示于节点左侧
+		textBox := seriesPainter.MeasureText(n.node.Name)
+		x := box.Right + labelMargin
+		if n.column == g.columnCount-1 && g.columnCount > 1 {
+			x = box.Left - labelMargin - textBox.Width()
+		}
+		y := (box.Top+box.Bottom)>>1 + textBox.Height()>>1
+		seriesPainter.Text(n.node.Name, x, y)
+	}
+	return s.p.box, nil
+}
+
+func (s *sankeyChart) Render() (Box, error) {
+	opt := s.opt
+
+	renderResult, err := defaultRender(s.p, defaultRenderOption{
+		Theme:   opt.Theme,
+		Padding: opt.Padding,
+		XAxis: XAxisOption{
+			Show: FalseFlag(),
+		},
+		YAxisOptions: []YAxisOption{
+			{
+				Show: FalseFlag(),
+			},
+		},
+		TitleOption: opt.Title,
+		LegendOption: LegendOption{
+			Show: FalseFlag(),
+		},
+		backgroundIsFilled: opt.backgroundIsFilled,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return s.render(renderResult, opt.Nodes, opt.Links)
+}
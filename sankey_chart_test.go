@@ -0,0 +1,251 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSankeyGraph(t *testing.T) {
+	assert := assert.New(t)
+
+	nodes := []SankeyNode{
+		{
+			Name: "a",
+		},
+		{
+			Name: "b",
+		},
+		{
+			Name: "c",
+		},
+		{
+			Name: "d",
+		},
+	}
+	g, err := newSankeyGraph(nodes, []SankeyLink{
+		{
+			Source: "a",
+			Target: "b",
+			Value:  5,
+		},
+		{
+			Source: "b",
+			Target: "c",
+			Value:  3,
+		},
+		{
+			Source: "a",
+			Target: "d",
+			Value:  2,
+		},
+	})
+	assert.Nil(err)
+	assert.Equal(3, g.columnCount)
+	columns := make([]int, 0)
+	values := make([]float64, 0)
+	for _, n := range g.nodes {
+		columns = append(columns, n.column)
+		values = append(values, n.value)
+	}
+	// 无流出的节点d放置于最后一列
+	assert.Equal([]int{0, 1, 2, 2}, columns)
+	assert.Equal([]float64{7, 5, 3, 2}, values)
+
+	g.layout(100, 100, 10, 10)
+	assert.Equal(0.0, g.nodes[0].x)
+	assert.Equal(45.0, g.nodes[1].x)
+	assert.Equal(90.0, g.nodes[2].x)
+	// 第一列的比例最小，占满高度
+	assert.Equal(100.0, g.nodes[0].height)
+	assert.Equal(0.0, g.nodes[0].y)
+	// 节点b的流出连线起始于节点顶部
+	assert.Equal(g.nodes[1].y, g.nodes[1].sourceLinks[0].sy)
+	assert.Equal(g.nodes[2].y, g.nodes[1].sourceLinks[0].ty)
+
+	_, err = newSankeyGraph(nil, nil)
+	assert.Equal("The nodes of sankey should not be empty", err.Error())
+
+	_, err = newSankeyGraph(append(nodes, SankeyNode{
+		Name: "a",
+	}), nil)
+	assert.Equal("The node of sankey is duplicated: a", err.Error())
+
+	_, err = newSankeyGraph(nodes, []SankeyLink{
+		{
+			Source: "a",
+			Target: "e",
+			Value:  1,
+		},
+	})
+	assert.Equal("The node of sankey link is not found: e", err.Error())
+
+	_, err = newSankeyGraph(nodes, []SankeyLink{
+		{
+			Source: "a",
+			Target: "b",
+			Value:  1,
+		},
+		{
+			Source: "b",
+			Target: "a",
+			Value:  1,
+		},
+	})
+	assert.Equal("The links of sankey should not be cyclic", err.Error())
+}
+
+func TestSankeyChart(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		render func(*Painter) ([]byte, error)
+		result string
+	}{
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewSankeyChart(p, SankeyChartOption{
+					Title: TitleOption{
+						Text: "Traffic",
+					},
+					Nodes: []SankeyNode{
+						{
+							Name: "ingress",
+						},
+						{
+							Name: "cdn",
+						},
+						{
+							Name: "gateway",
+						},
+						{
+							Name: "users",
+						},
+						{
+							Name:  "orders",
+							Color: parseColor("#bbb"),
+						},
+					},
+					Links: []SankeyLink{
+						{
+							Source: "ingress",
+							Target: "gateway",
+							Value:  70,
+						},
+						{
+							Source: "ingress",
+							Target: "cdn",
+							Value:  30,
+						},
+						{
+							Source: "gateway",
+							Target: "users",
+							Value:  30,
+						},
+						{
+							Source: "gateway",
+							Target: "orders",
+							Value:  40,
+						},
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"0\" y=\"15\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Traffic</text><path  d=\"M 20 139\nQ87,139 155,115\nQ222,91 290,91\nL 290 314\nQ222,314 155,338\nQ87,362 20,362\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,0.4)\"/><path  d=\"M 20 43\nQ160,43 300,39\nQ440,35 580,35\nL 580 131\nQ440,131 300,135\nQ160,139 20,139\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,0.4)\"/><path  d=\"M 310 91\nQ377,91 445,115\nQ512,139 580,139\nL 580 234\nQ512,234 445,210\nQ377,187 310,187\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(250,200,88,0.4)\"/><path  d=\"M 310 187\nQ377,187 445,214\nQ512,242 580,242\nL 580 370\nQ512,370 445,342\nQ377,314 310,314\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(250,200,88,0.4)\"/><path  d=\"M 0 43\nL 20 43\nL 20 362\nL 0 362\nL 0 43\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"25\" y=\"209\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">ingress</text><path  d=\"M 580 35\nL 600 35\nL 600 131\nL 580 131\nL 580 35\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"549\" y=\"90\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">cdn</text><path  d=\"M 290 91\nL 310 91\nL 310 314\nL 290 314\nL 290 91\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><text x=\"315\" y=\"209\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">gateway</text><path  d=\"M 580 139\nL 600 139\nL 600 234\nL 580 234\nL 580 139\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><text x=\"537\" y=\"193\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">users</text><path  d=\"M 580 242\nL 600 242\nL 600 370\nL 580 370\nL 580 242\" style=\"stroke-width:1;stroke:rgba(187,187,187,1.0);fill:rgba(187,187,187,1.0)\"/><text x=\"530\" y=\"313\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">orders</text></svg>",
+		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewSankeyChart(p, SankeyChartOption{
+					Padding: Box{
+						Top:    20,
+						Right:  20,
+						Bottom: 20,
+						Left:   20,
+					},
+					Sankey: SankeyOption{
+						NodeWidth: 10,
+						NodeGap:   20,
+					},
+					Nodes: []SankeyNode{
+						{
+							Name: "visit",
+						},
+						{
+							Name: "cart",
+						},
+						{
+							Name: "leave",
+						},
+						{
+							Name: "pay",
+						},
+					},
+					Links: []SankeyLink{
+						{
+							Source: "visit",
+							Target: "cart",
+							Value:  60,
+						},
+						{
+							Source: "visit",
+							Target: "leave",
+							Value:  40,
+						},
+						{
+							Source: "cart",
+							Target: "pay",
+							Value:  35,
+						},
+						{
+							Source: "cart",
+							Target: "leave",
+							Value:  25,
+						},
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 30 154\nQ96,154 162,123\nQ228,92 295,92\nL 295 278\nQ228,278 162,309\nQ96,340 30,340\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,0.4)\"/><path  d=\"M 30 30\nQ165,30 300,25\nQ435,20 570,20\nL 570 144\nQ435,144 300,149\nQ165,154 30,154\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,0.4)\"/><path  d=\"M 305 170\nQ371,170 437,206\nQ503,242 570,242\nL 570 350\nQ503,350 437,314\nQ371,278 305,278\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,0.4)\"/><path  d=\"M 305 92\nQ371,92 437,118\nQ503,144 570,144\nL 570 222\nQ503,222 437,196\nQ371,170 305,170\nZ\" style=\"stroke-width:0;stroke:none;fill:rgba(145,204,117,0.4)\"/><path  d=\"M 20 30\nL 30 30\nL 30 340\nL 20 340\nL 20 30\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"35\" y=\"192\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">visit</text><path  d=\"M 295 92\nL 305 92\nL 305 278\nL 295 278\nL 295 92\" style=\"stroke-width:1;stroke:rgba(145,204,117,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"310\" y=\"192\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">cart</text><path  d=\"M 570 20\nL 580 20\nL 580 222\nL 570 222\nL 570 20\" style=\"stroke-width:1;stroke:rgba(250,200,88,1.0);fill:rgba(250,200,88,1.0)\"/><text x=\"528\" y=\"128\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">leave</text><path  d=\"M 570 242\nL 580 242\nL 580 350\nL 570 350\nL 570 242\" style=\"stroke-width:1;stroke:rgba(238,102,102,1.0);fill:rgba(238,102,102,1.0)\"/><text x=\"540\" y=\"303\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">pay</text></svg>",
+		},
+	}
+
+	for _, tt := range tests {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		data, err := tt.render(p)
+		assert.Nil(err)
+		assert.Equal(tt.result, string(data))
+	}
+}
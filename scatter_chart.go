@@ -0,0 +1,236 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"math"
+
+	"github.com/golang/freetype/truetype"
+)
+
+type scatterChart struct {
+	p   *Painter
+	opt *ScatterChartOption
+}
+
+// NewScatterSeriesList returns a series list for scatter chart,
+// the value of each point is [x, y] or [x, y, size]
+func NewScatterSeriesList(values [][][]float64) SeriesList {
+	seriesList := make(SeriesList, len(values))
+	for index, points := range values {
+		data := make([]SeriesData, len(points))
+		for j, value := range points {
+			data[j] = newScatterSeriesData(value)
+		}
+		seriesList[index] = Series{
+			Type: ChartTypeScatter,
+			Data: data,
+		}
+	}
+	return seriesList
+}
+
+func newScatterSeriesData(values []float64) SeriesData {
+	data := SeriesData{}
+	if len(values) > 0 {
+		data.XValue = values[0]
+	}
+	if len(values) > 1 {
+		data.Value = values[1]
+	}
+	if len(values) > 2 {
+		data.Size = values[2]
+	}
+	return data
+}
+
+// NewScatterChart returns a scatter chart renderer
+func NewScatterChart(p *Painter, opt ScatterChartOption) *scatterChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &scatterChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+type ScatterChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The data series list
+	SeriesList SeriesList
+	// The x axis option
+	XAxis XAxisOption
+	// The padding of scatter chart
+	Padding Box
+	// The y axis option
+	YAxisOptions []YAxisOption
+	// The option of title
+	Title TitleOption
+	// The legend option
+	Legend LegendOption
+	// The symbol size of scatter, default value is 10
+	SymbolSize float64
+	// background is filled
+	backgroundIsFilled bool
+}
+
+const defaultScatterSymbolSize = 10.0
+const defaultBubbleMaxSymbolSize = 40.0
+
+func (s *scatterChart) render(result *defaultRenderResult, seriesList SeriesList) (Box, error) {
+	p := s.p
+	opt := s.opt
+	seriesPainter := result.seriesPainter
+	xRange := result.xAxisRange
+
+	symbolSize := opt.SymbolSize
+	if symbolSize <= 0 {
+		symbolSize = defaultScatterSymbolSize
+	}
+	// 气泡图的size按最大值等比缩放
+	maxSize := float64(0)
+	for _, series := range seriesList {
+		for _, item := range series.Data {
+			if item.Size > maxSize {
+				maxSize = item.Size
+			}
+		}
+	}
+
+	markPointPainter := NewMarkPointPainter(seriesPainter)
+	markLinePainter := NewMarkLinePainter(seriesPainter)
+	rendererList := []Renderer{
+		markPointPainter,
+		markLinePainter,
+	}
+	seriesNames := seriesList.Names()
+	for index := range seriesList {
+		series := seriesList[index]
+		seriesColor := opt.Theme.GetSeriesColor(series.index)
+		yRange := result.axisRanges[series.AxisIndex]
+
+		var labelPainter *SeriesLabelPainter
+		if series.Label.Show {
+			labelPainter = NewSeriesLabelPainter(SeriesLabelPainterParams{
+				P:           seriesPainter,
+				SeriesNames: seriesNames,
+				Label:       series.Label,
+				Theme:       opt.Theme,
+				Font:        opt.Font,
+			})
+			rendererList = append(rendererList, labelPainter)
+		}
+		points := make([]Point, len(series.Data))
+		for j, item := range series.Data {
+			if item.Value == nullValue {
+				continue
+			}
+			xValue := item.XValue
+			if opt.XAxis.Type == AxisTypeTime {
+				xValue = timeToValue(item.Time)
+			}
+			x := xRange.getHeight(xValue)
+			y := yRange.getRestHeight(item.Value)
+			points[j] = Point{
+				X: x,
+				Y: y,
+			}
+			size := symbolSize
+			if maxSize > 0 && item.Size > 0 {
+				// 面积与值成正比
+				size = math.Sqrt(item.Size/maxSize) * defaultBubbleMaxSymbolSize
+			}
+			fillColor := seriesColor
+			if !item.Style.FillColor.IsZero() {
+				fillColor = item.Style.FillColor
+			}
+			seriesPainter.OverrideDrawingStyle(Style{
+				StrokeColor: fillColor,
+				StrokeWidth: 1,
+				FillColor:   fillColor.WithAlpha(200),
+			})
+			seriesPainter.Circle(size/2, x, y)
+			seriesPainter.FillStroke()
+
+			// 如果label不需要展示，则返回
+			if labelPainter == nil {
+				continue
+			}
+			labelPainter.Add(LabelValue{
+				Index:    index,
+				Value:    item.Value,
+				X:        x,
+				Y:        y - int(size/2),
+				Offset:   series.Label.Offset,
+				FontSize: series.Label.FontSize,
+			})
+		}
+		markPointPainter.Add(markPointRenderOption{
+			FillColor: seriesColor,
+			Font:      opt.Font,
+			Points:    points,
+			Series:    series,
+		})
+		markLinePainter.Add(markLineRenderOption{
+			FillColor:   seriesColor,
+			FontColor:   opt.Theme.GetTextColor(),
+			StrokeColor: seriesColor,
+			Font:        opt.Font,
+			Series:      series,
+			Range:       yRange,
+		})
+	}
+	// 最大、最小的mark point
+	err := doRender(rendererList...)
+	if err != nil {
+		return BoxZero, err
+	}
+
+	return p.box, nil
+}
+
+func (s *scatterChart) Render() (Box, error) {
+	p := s.p
+	opt := s.opt
+
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		Theme:              opt.Theme,
+		Padding:            opt.Padding,
+		SeriesList:         opt.SeriesList,
+		XAxis:              opt.XAxis,
+		YAxisOptions:       opt.YAxisOptions,
+		TitleOption:        opt.Title,
+		LegendOption:       opt.Legend,
+		backgroundIsFilled: opt.backgroundIsFilled,
+		xAxisIsValue:       true,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	seriesList := opt.SeriesList.Filter(ChartTypeScatter)
+	return s.render(renderResult, seriesList)
+}
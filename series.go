@@ -23,7 +23,9 @@ package charts
 
 import (
 	"math"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/wcharczuk/go-chart/v2"
@@ -32,6 +34,27 @@ import (
 type SeriesData struct {
 	// The value of series data
 	Value float64
+	// The x value of series data, it's used for scatter chart
+	XValue float64
+	// The time of series data, it's used for time x axis
+	Time time.Time
+	// The size of series data, it's the third dimension of bubble chart
+	Size float64
+	// The data of gantt chart
+	Gantt *GanttData
+	// The data of candlestick chart
+	Candlestick *CandlestickData
+	// The data of box plot
+	BoxPlot *BoxPlotData
+	// The data of waterfall chart
+	Waterfall *WaterfallData
+	// The data of bullet chart
+	Bullet *BulletData
+	// The lower and upper bounds of series data, they are drawn as error bar of bar chart
+	// or confidence band of line chart
+	Bounds *BoundsData
+	// The data of parallel chart
+	Parallel *ParallelData
 	// The style of series data
 	Style Style
 }
@@ -56,6 +79,17 @@ func NewSeriesFromValues(values []float64, chartType ...string) Series {
 	return s
 }
 
+// NewSeriesDataFromTimeValues returns a series data of time axis
+func NewSeriesDataFromTimeValues(times []time.Time, values []float64) []SeriesData {
+	data := NewSeriesDataFromValues(values)
+	for index := range data {
+		if index < len(times) {
+			data[index].Time = times[index]
+		}
+	}
+	return data
+}
+
 // NewSeriesDataFromValues return a series data
 func NewSeriesDataFromValues(values []float64) []SeriesData {
 	data := make([]SeriesData, len(values))
@@ -67,6 +101,55 @@ func NewSeriesDataFromValues(values []float64) []SeriesData {
 	return data
 }
 
+// BoundsData is the lower and upper bounds of series data,
+// the missing bound is the value of series data
+type BoundsData struct {
+	// The lower bound
+	Lower *float64
+	// The upper bound
+	Upper *float64
+}
+
+// NewSeriesDataFromBounds returns a series data with lower and upper bounds,
+// the bound is ignored if it's null value
+func NewSeriesDataFromBounds(values, lowers, uppers []float64) []SeriesData {
+	data := NewSeriesDataFromValues(values)
+	for index := range data {
+		bounds := BoundsData{}
+		if index < len(lowers) && lowers[index] != nullValue {
+			bounds.Lower = NewFloatPoint(lowers[index])
+		}
+		if index < len(uppers) && uppers[index] != nullValue {
+			bounds.Upper = NewFloatPoint(uppers[index])
+		}
+		if bounds.Lower != nil || bounds.Upper != nil {
+			data[index].Bounds = &bounds
+		}
+	}
+	return data
+}
+
+// getSeriesDataBounds returns the lower and upper bounds of series data,
+// the missing bound is the value of series data
+func getSeriesDataBounds(item SeriesData) (float64, float64, bool) {
+	bounds := item.Bounds
+	if item.Value == nullValue || bounds == nil || (bounds.Lower == nil && bounds.Upper == nil) {
+		return 0, 0, false
+	}
+	lower := item.Value
+	upper := item.Value
+	if bounds.Lower != nil {
+		lower = *bounds.Lower
+	}
+	if bounds.Upper != nil {
+		upper = *bounds.Upper
+	}
+	if lower == nullValue || upper == nullValue {
+		return 0, 0, false
+	}
+	return lower, upper, true
+}
+
 type SeriesLabel struct {
 	// Data label formatter, which supports string template.
 	// {b}: the name of a data item.
@@ -126,6 +209,13 @@ type Series struct {
 	Name string
 	// Radius for Pie chart, e.g.: 40%, default is "40%"
 	Radius string
+	// Inner radius for Pie chart, it will be drawn as donut if it's set, e.g.: 20%
+	InnerRadius string
+	// Rose type for Pie chart, "radius" or "area", the radius of sector
+	// is scaled by value, the sectors of "area" have the same angle
+	RoseType string
+	// The label of donut center, {c} is the sum value of pie chart
+	CenterLabel SeriesLabel
 	// Mark point for series
 	MarkPoint SeriesMarkPoint
 	// Make line for series
@@ -134,6 +224,14 @@ type Series struct {
 	Min *float64
 	// Min value of series
 	Max *float64
+	// The stack group of series, the series with the same stack
+	// and chart type are stacked cumulatively
+	Stack string
+	// Normalize the values of stack group to percent, each category sums to 100%
+	StackPercent bool
+	// The step position of line chart, "start", "middle" or "end",
+	// the line is drawn as horizontal and vertical segments if it's set
+	Step string
 }
 type SeriesList []Series
 
@@ -162,34 +260,284 @@ func (sl SeriesList) Filter(chartType string) SeriesList {
 	return arr
 }
 
+// GetXMaxMin get max and min x value of series list, it's used for value x axis
+func (sl SeriesList) GetXMaxMin() (float64, float64) {
+	min := math.MaxFloat64
+	max := -math.MaxFloat64
+	for _, series := range sl {
+		for _, item := range series.Data {
+			// 如果为空值，忽略
+			if item.Value == nullValue {
+				continue
+			}
+			if item.XValue > max {
+				max = item.XValue
+			}
+			if item.XValue < min {
+				min = item.XValue
+			}
+		}
+	}
+	// 无有效数据
+	if max < min {
+		return 0, 0
+	}
+	return max, min
+}
+
+// GetTimeMaxMin get max and min time of series list, it's used for time x axis
+func (sl SeriesList) GetTimeMaxMin() (time.Time, time.Time) {
+	var min, max time.Time
+	for _, series := range sl {
+		for _, item := range series.Data {
+			// 如果为空值，忽略
+			if item.Value == nullValue || item.Time.IsZero() {
+				continue
+			}
+			if max.IsZero() || item.Time.After(max) {
+				max = item.Time
+			}
+			if min.IsZero() || item.Time.Before(min) {
+				min = item.Time
+			}
+			// 甘特图的任务使用结束时间
+			if endTime := item.ganttEndTime(); endTime.After(max) {
+				max = endTime
+			}
+		}
+	}
+	return max, min
+}
+
+// hasBar returns true if there is bar series of the axis
+func (sl SeriesList) hasBar(axisIndex int) bool {
+	for _, series := range sl {
+		if series.AxisIndex != axisIndex {
+			continue
+		}
+		if series.Type == ChartTypeBar ||
+			series.Type == ChartTypeHorizontalBar ||
+			series.Type == ChartTypeBullet {
+			return true
+		}
+	}
+	return false
+}
+
 // GetMaxMin get max and min value of series list
 func (sl SeriesList) GetMaxMin(axisIndex int) (float64, float64) {
 	min := math.MaxFloat64
 	max := -math.MaxFloat64
-	for _, series := range sl {
+	stackValues := sl.getStackValues()
+	for index, series := range sl {
 		if series.AxisIndex != axisIndex {
 			continue
 		}
-		for _, item := range series.Data {
+		var waterfallBars []waterfallBar
+		if series.Type == ChartTypeWaterfall {
+			waterfallBars = getWaterfallBars(series.Data)
+		}
+		for j, item := range series.Data {
 			// 如果为空值，忽略
-			if item.Value == nullValue {
+			if item.Value == nullValue && !item.isWaterfallTotal() {
 				continue
 			}
-			if item.Value > max {
-				max = item.Value
+			// 堆叠的使用累加后的值
+			itemMax := stackValues.values[index][j] + stackValues.baseValues[index][j]
+			itemMin := itemMax
+			// k线图使用最高与最低值
+			if series.Type == ChartTypeCandlestick && item.Candlestick != nil {
+				itemMax = item.Candlestick.High
+				itemMin = item.Candlestick.Low
+			}
+			// 箱线图使用须线及异常值
+			if series.Type == ChartTypeBoxPlot && item.BoxPlot != nil {
+				itemMax = item.BoxPlot.High
+				itemMin = item.BoxPlot.Low
+				for _, v := range item.BoxPlot.Outliers {
+					itemMax = math.Max(itemMax, v)
+					itemMin = math.Min(itemMin, v)
+				}
 			}
-			if item.Value < min {
-				min = item.Value
+			// 瀑布图使用柱的起止值
+			if series.Type == ChartTypeWaterfall {
+				itemMax = math.Max(waterfallBars[j].start, waterfallBars[j].end)
+				itemMin = math.Min(waterfallBars[j].start, waterfallBars[j].end)
+			}
+			// 误差范围，堆叠时以前一系列为基准
+			if lower, upper, ok := getSeriesDataBounds(item); ok && !stackValues.percents[index] {
+				baseValue := stackValues.baseValues[index][j]
+				itemMax = math.Max(itemMax, upper+baseValue)
+				itemMin = math.Min(itemMin, lower+baseValue)
+			}
+			// 子弹图包括目标值与区间
+			if series.Type == ChartTypeBullet && item.Bullet != nil {
+				if item.Bullet.Target != nil {
+					itemMax = math.Max(itemMax, *item.Bullet.Target)
+					itemMin = math.Min(itemMin, *item.Bullet.Target)
+				}
+				for _, v := range item.Bullet.Ranges {
+					itemMax = math.Max(itemMax, v)
+					itemMin = math.Min(itemMin, v)
+				}
+			}
+			if itemMax > max {
+				max = itemMax
+			}
+			if itemMin < min {
+				min = itemMin
 			}
 		}
 	}
 	return max, min
 }
 
+func (series *Series) stackKey() string {
+	return series.Type + ":" + strconv.Itoa(series.AxisIndex) + ":" + series.Stack
+}
+
+type seriesStackValues struct {
+	// The values for rendering, they are percent values(0-100) for percent stack
+	values [][]float64
+	// The base values of stacked series data
+	baseValues [][]float64
+	// The flag of percent stack for each series
+	percents []bool
+}
+
+// getStackValues returns the stack values of series list.
+// The base value is the sum of the previous series data in the same stack,
+// and the positive and negative values are stacked separately.
+func (sl SeriesList) getStackValues() seriesStackValues {
+	result := seriesStackValues{
+		values:     make([][]float64, len(sl)),
+		baseValues: make([][]float64, len(sl)),
+		percents:   make([]bool, len(sl)),
+	}
+	// 百分比堆叠的分组
+	percentKeys := make(map[string]bool)
+	for index := range sl {
+		series := sl[index]
+		if series.Stack != "" && series.StackPercent {
+			percentKeys[series.stackKey()] = true
+		}
+	}
+	// 百分比堆叠的各分组总和
+	totals := make(map[string][]float64)
+	for index := range sl {
+		series := sl[index]
+		values := make([]float64, len(series.Data))
+		for j, item := range series.Data {
+			values[j] = item.Value
+		}
+		result.values[index] = values
+		result.baseValues[index] = make([]float64, len(series.Data))
+		if series.Stack == "" || !percentKeys[series.stackKey()] {
+			continue
+		}
+		key := series.stackKey()
+		result.percents[index] = true
+		sums := totals[key]
+		for j, item := range series.Data {
+			for len(sums) <= j {
+				sums = append(sums, 0)
+			}
+			if item.Value != nullValue {
+				sums[j] += math.Abs(item.Value)
+			}
+		}
+		totals[key] = sums
+	}
+
+	positiveValues := make(map[string][]float64)
+	negativeValues := make(map[string][]float64)
+	for index := range sl {
+		series := sl[index]
+		if series.Stack == "" {
+			continue
+		}
+		key := series.stackKey()
+		values := result.values[index]
+		baseValues := result.baseValues[index]
+		for j, value := range values {
+			if value == nullValue {
+				continue
+			}
+			if result.percents[index] {
+				total := totals[key][j]
+				if total != 0 {
+					value = value / total * 100
+				}
+				values[j] = value
+			}
+			stackValues := positiveValues
+			if value < 0 {
+				stackValues = negativeValues
+			}
+			sums := stackValues[key]
+			for len(sums) <= j {
+				sums = append(sums, 0)
+			}
+			baseValues[j] = sums[j]
+			sums[j] += value
+			stackValues[key] = sums
+		}
+	}
+	return result
+}
+
+// getPercent returns the percent of series data for label, it's nil if not percent stack
+func (sv *seriesStackValues) getPercent(index, dataIndex int) *float64 {
+	if !sv.percents[index] {
+		return nil
+	}
+	value := sv.values[index][dataIndex]
+	if value == nullValue {
+		return nil
+	}
+	return NewFloatPoint(value / 100)
+}
+
+// isStackPercent checks the series of axis are percent stacked
+func (sl SeriesList) isStackPercent(axisIndex int) bool {
+	for _, series := range sl {
+		if series.AxisIndex == axisIndex &&
+			series.Stack != "" &&
+			series.StackPercent {
+			return true
+		}
+	}
+	return false
+}
+
+// getStackIndexes returns the position index of each series and the count of positions,
+// the series in the same stack share the same position
+func (sl SeriesList) getStackIndexes() ([]int, int) {
+	indexes := make([]int, len(sl))
+	stackIndexes := make(map[string]int)
+	count := 0
+	for index := range sl {
+		series := sl[index]
+		if series.Stack != "" {
+			key := series.stackKey()
+			if value, ok := stackIndexes[key]; ok {
+				indexes[index] = value
+				continue
+			}
+			stackIndexes[key] = count
+		}
+		indexes[index] = count
+		count++
+	}
+	return indexes, count
+}
+
 type PieSeriesOption struct {
-	Radius string
-	Label  SeriesLabel
-	Names  []string
+	Radius      string
+	InnerRadius string
+	RoseType    string
+	Label       SeriesLabel
+	Names       []string
 }
 
 func NewPieSeriesList(values []float64, opts ...PieSeriesOption) SeriesList {
@@ -210,9 +558,11 @@ func NewPieSeriesList(values []float64, opts ...PieSeriesOption) SeriesList {
 					Value: v,
 				},
 			},
-			Radius: opt.Radius,
-			Label:  opt.Label,
-			Name:   name,
+			Radius:      opt.Radius,
+			InnerRadius: opt.InnerRadius,
+			RoseType:    opt.RoseType,
+			Label:       opt.Label,
+			Name:        name,
 		}
 		result[index] = s
 	}
@@ -49,6 +49,8 @@ type LabelValue struct {
 	FontSize float64
 	Orient   string
 	Offset   Box
+	// The percent of value, it's used for {d} of formatter
+	Percent *float64
 }
 
 type SeriesLabelPainter struct {
@@ -85,7 +87,11 @@ func (o *SeriesLabelPainter) Add(value LabelValue) {
 	if distance == 0 {
 		distance = 5
 	}
-	text := NewValueLabelFormatter(o.seriesNames, label.Formatter)(value.Index, value.Value, -1)
+	percent := -1.0
+	if value.Percent != nil {
+		percent = *value.Percent
+	}
+	text := NewValueLabelFormatter(o.seriesNames, label.Formatter)(value.Index, value.Value, percent)
 	labelStyle := Style{
 		FontColor: o.theme.GetTextColor(),
 		FontSize:  labelFontSize,
@@ -46,6 +46,61 @@ func TestNewSeriesListDataFromValues(t *testing.T) {
 	}, ChartTypeBar))
 }
 
+func TestNewSeriesDataFromBounds(t *testing.T) {
+	assert := assert.New(t)
+
+	data := NewSeriesDataFromBounds([]float64{
+		10,
+		20,
+		30,
+	}, []float64{
+		8,
+		nullValue,
+	}, []float64{
+		12,
+		25,
+		33,
+	})
+	assert.Equal(3, len(data))
+	assert.Equal(8.0, *data[0].Bounds.Lower)
+	assert.Equal(12.0, *data[0].Bounds.Upper)
+	assert.Nil(data[1].Bounds.Lower)
+	assert.Equal(25.0, *data[1].Bounds.Upper)
+	assert.Nil(data[2].Bounds.Lower)
+	assert.Equal(33.0, *data[2].Bounds.Upper)
+	// 无上下边界
+	assert.Nil(NewSeriesDataFromBounds([]float64{
+		10,
+	}, nil, nil)[0].Bounds)
+
+	lower, upper, ok := getSeriesDataBounds(data[1])
+	assert.True(ok)
+	assert.Equal(20.0, lower)
+	assert.Equal(25.0, upper)
+
+	_, _, ok = getSeriesDataBounds(SeriesData{
+		Value: 10,
+	})
+	assert.False(ok)
+	_, _, ok = getSeriesDataBounds(SeriesData{
+		Value: nullValue,
+		Bounds: &BoundsData{
+			Upper: NewFloatPoint(10),
+		},
+	})
+	assert.False(ok)
+
+	seriesList := SeriesList{
+		{
+			Type: ChartTypeLine,
+			Data: data,
+		},
+	}
+	max, min := seriesList.GetMaxMin(0)
+	assert.Equal(33.0, max)
+	assert.Equal(8.0, min)
+}
+
 func TestSeriesLists(t *testing.T) {
 	assert := assert.New(t)
 	seriesList := NewSeriesListDataFromValues([][]float64{
@@ -72,6 +127,122 @@ func TestSeriesLists(t *testing.T) {
 		MinValue:     1,
 		AverageValue: 1.5,
 	}, seriesList[0].Summary())
+
+	seriesList = NewScatterSeriesList([][][]float64{
+		{
+			{
+				3,
+				1,
+			},
+			{
+				-2,
+				5,
+			},
+		},
+	})
+	max, min = seriesList.GetXMaxMin()
+	assert.Equal(float64(3), max)
+	assert.Equal(float64(-2), min)
+
+	// 无有效数据
+	max, min = NewScatterSeriesList([][][]float64{
+		{},
+	}).GetXMaxMin()
+	assert.Equal(float64(0), max)
+	assert.Equal(float64(0), min)
+}
+
+func TestSeriesListStack(t *testing.T) {
+	assert := assert.New(t)
+	seriesList := NewSeriesListDataFromValues([][]float64{
+		{
+			1,
+			-2,
+		},
+		{
+			3,
+			-4,
+		},
+		{
+			5,
+			6,
+		},
+	}, ChartTypeBar)
+	seriesList[0].Stack = "total"
+	seriesList[1].Stack = "total"
+
+	assert.Equal([][]float64{
+		{
+			0,
+			0,
+		},
+		{
+			1,
+			-2,
+		},
+		{
+			0,
+			0,
+		},
+	}, seriesList.getStackValues().baseValues)
+
+	indexes, count := seriesList.getStackIndexes()
+	assert.Equal([]int{
+		0,
+		0,
+		1,
+	}, indexes)
+	assert.Equal(2, count)
+
+	max, min := seriesList.GetMaxMin(0)
+	assert.Equal(float64(6), max)
+	assert.Equal(float64(-6), min)
+}
+
+func TestSeriesListStackPercent(t *testing.T) {
+	assert := assert.New(t)
+	seriesList := NewSeriesListDataFromValues([][]float64{
+		{
+			1,
+			6,
+		},
+		{
+			3,
+			2,
+		},
+	}, ChartTypeBar)
+	for index := range seriesList {
+		seriesList[index].Stack = "total"
+		seriesList[index].StackPercent = true
+	}
+
+	stackValues := seriesList.getStackValues()
+	assert.Equal([][]float64{
+		{
+			25,
+			75,
+		},
+		{
+			75,
+			25,
+		},
+	}, stackValues.values)
+	assert.Equal([][]float64{
+		{
+			0,
+			0,
+		},
+		{
+			25,
+			75,
+		},
+	}, stackValues.baseValues)
+	assert.Equal(0.75, *stackValues.getPercent(1, 0))
+	assert.True(seriesList.isStackPercent(0))
+
+	max, min := seriesList.GetMaxMin(0)
+	assert.Equal(float64(100), max)
+	assert.Equal(float64(25), min)
 }
 
 func TestFormatter(t *testing.T) {
@@ -0,0 +1,311 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"math"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+type sparklineChart struct {
+	p   *Painter
+	opt *SparklineChartOption
+}
+
+// NewSparklineChart returns a sparkline chart renderer,
+// it fills the painter without axis, legend, title and padding
+func NewSparklineChart(p *Painter, opt SparklineChartOption) *sparklineChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &sparklineChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+type SparklineOption struct {
+	// Highlight the last point
+	ShowLast bool
+	// Highlight the min point
+	ShowMin bool
+	// Highlight the max point
+	ShowMax bool
+	// The color of max point, default is the color of series
+	MaxColor Color
+	// The color of min point, default is the color of series
+	MinColor Color
+	// The radius of highlight point, default is 2
+	SymbolSize float64
+}
+
+type SparklineChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The data series list, the series of bar type is drawn as bars, others are drawn as lines
+	SeriesList SeriesList
+	// The stroke width of line, default is 1
+	StrokeWidth float64
+	// Fill the area of line
+	FillArea bool
+	// background fill (alpha) opacity
+	Opacity uint8
+	// The option of sparkline
+	Sparkline SparklineOption
+}
+
+const defaultSparklineWidth = 120
+const defaultSparklineHeight = 30
+const defaultSparklineSymbolSize = 2.0
+
+// hasHighlight returns true if any point should be highlighted
+func (opt *SparklineOption) hasHighlight() bool {
+	return opt.ShowLast || opt.ShowMin || opt.ShowMax
+}
+
+// getSparklineExtremeIndexes returns the index of min and max value, -1 if not found
+func getSparklineExtremeIndexes(data []SeriesData) (int, int) {
+	minIndex := -1
+	maxIndex := -1
+	for index, item := range data {
+		if item.Value == nullValue {
+			continue
+		}
+		if minIndex < 0 || item.Value < data[minIndex].Value {
+			minIndex = index
+		}
+		if maxIndex < 0 || item.Value > data[maxIndex].Value {
+			maxIndex = index
+		}
+	}
+	return minIndex, maxIndex
+}
+
+// getSparklineLastIndex returns the index of last value, -1 if not found
+func getSparklineLastIndex(data []SeriesData) int {
+	for index := len(data) - 1; index >= 0; index-- {
+		if data[index].Value != nullValue {
+			return index
+		}
+	}
+	return -1
+}
+
+func (s *sparklineChart) Render() (Box, error) {
+	p := s.p
+	opt := s.opt
+	theme := opt.Theme
+	seriesList := opt.SeriesList
+	seriesList.init()
+
+	hasBar := false
+	count := 0
+	min := math.MaxFloat64
+	max := -math.MaxFloat64
+	for _, series := range seriesList {
+		if series.Type == ChartTypeBar {
+			hasBar = true
+		}
+		count = chart.MaxInt(count, len(series.Data))
+		for _, item := range series.Data {
+			if item.Value == nullValue {
+				continue
+			}
+			min = math.Min(min, item.Value)
+			max = math.Max(max, item.Value)
+		}
+	}
+	// 无数据
+	if min > max {
+		return p.box, nil
+	}
+	// 柱状图由0开始
+	if hasBar {
+		min = math.Min(min, 0)
+		max = math.Max(max, 0)
+	}
+
+	strokeWidth := opt.StrokeWidth
+	if strokeWidth <= 0 {
+		strokeWidth = 1
+	}
+	symbolSize := opt.Sparkline.SymbolSize
+	if symbolSize <= 0 {
+		symbolSize = defaultSparklineSymbolSize
+	}
+	// 预留线宽与高亮点的空间，避免被裁剪
+	margin := strokeWidth / 2
+	if opt.Sparkline.hasHighlight() {
+		margin = math.Max(margin, symbolSize+1)
+	}
+	inset := int(math.Ceil(margin))
+	width := p.Width()
+	height := p.Height() - 2*inset
+	if width <= 0 || height <= 0 {
+		return p.box, nil
+	}
+	getY := func(value float64) int {
+		// 所有值相同则居中展示
+		if max == min {
+			return inset + height>>1
+		}
+		return inset + int(math.Round((max-value)/(max-min)*float64(height)))
+	}
+
+	for _, series := range seriesList {
+		seriesColor := theme.GetSeriesColor(series.index)
+		minIndex, maxIndex := getSparklineExtremeIndexes(series.Data)
+		lastIndex := getSparklineLastIndex(series.Data)
+		getHighlightColor := func(index int) (Color, bool) {
+			switch {
+			case opt.Sparkline.ShowLast && index == lastIndex:
+				return seriesColor, true
+			case opt.Sparkline.ShowMax && index == maxIndex:
+				if !opt.Sparkline.MaxColor.IsZero() {
+					return opt.Sparkline.MaxColor, true
+				}
+				return seriesColor, true
+			case opt.Sparkline.ShowMin && index == minIndex:
+				if !opt.Sparkline.MinColor.IsZero() {
+					return opt.Sparkline.MinColor, true
+				}
+				return seriesColor, true
+			}
+			return seriesColor, false
+		}
+
+		if series.Type == ChartTypeBar {
+			xValues := autoDivide(width, count)
+			zeroY := getY(0)
+			// 有高亮时其它柱条使用较淡的颜色
+			barColor := seriesColor
+			if opt.Sparkline.hasHighlight() {
+				barColor = interpolateColor([]Color{
+					seriesColor,
+					theme.GetBackgroundColor(),
+				}, 0.5)
+			}
+			for index, item := range series.Data {
+				if item.Value == nullValue {
+					continue
+				}
+				left := xValues[index]
+				right := xValues[index+1]
+				// 空间足够时柱条间保留1px间隔
+				if right-left > 2 {
+					right--
+				}
+				top := getY(item.Value)
+				bottom := zeroY
+				if top > bottom {
+					top, bottom = bottom, top
+				}
+				// 至少展示1px
+				if bottom == top {
+					bottom++
+				}
+				color, ok := getHighlightColor(index)
+				if !ok {
+					color = barColor
+				}
+				p.OverrideDrawingStyle(Style{
+					FillColor: color,
+				}).Rect(Box{
+					Top:    top,
+					Left:   left,
+					Right:  right,
+					Bottom: bottom,
+				})
+			}
+			continue
+		}
+
+		points := make([]Point, len(series.Data))
+		areaPoints := make([]Point, 0, len(series.Data)+3)
+		for index, item := range series.Data {
+			x := inset + (width-2*inset)>>1
+			if count > 1 {
+				x = inset + index*(width-2*inset)/(count-1)
+			}
+			y := int(math.MaxInt32)
+			if item.Value != nullValue {
+				y = getY(item.Value)
+				areaPoints = append(areaPoints, Point{
+					X: x,
+					Y: y,
+				})
+			}
+			points[index] = Point{
+				X: x,
+				Y: y,
+			}
+		}
+		if opt.FillArea && len(areaPoints) > 1 {
+			bottomY := inset + height
+			areaPoints = append(areaPoints, Point{
+				X: areaPoints[len(areaPoints)-1].X,
+				Y: bottomY,
+			}, Point{
+				X: areaPoints[0].X,
+				Y: bottomY,
+			}, areaPoints[0])
+			var opacity uint8 = 200
+			if opt.Opacity != 0 {
+				opacity = opt.Opacity
+			}
+			p.SetDrawingStyle(Style{
+				FillColor: seriesColor.WithAlpha(opacity),
+			})
+			p.FillArea(areaPoints)
+		}
+		p.SetDrawingStyle(Style{
+			StrokeColor: seriesColor,
+			StrokeWidth: strokeWidth,
+		})
+		p.LineStroke(points)
+
+		// 高亮的点
+		for _, index := range []int{
+			minIndex,
+			maxIndex,
+			lastIndex,
+		} {
+			if index < 0 {
+				continue
+			}
+			color, ok := getHighlightColor(index)
+			if !ok {
+				continue
+			}
+			p.OverrideDrawingStyle(Style{
+				StrokeWidth: 1,
+				StrokeColor: color,
+				FillColor:   color,
+			})
+			p.Circle(symbolSize, points[index].X, points[index].Y)
+			p.FillStroke()
+		}
+	}
+	return p.box, nil
+}
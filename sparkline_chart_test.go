@@ -0,0 +1,150 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSparklineIndexes(t *testing.T) {
+	assert := assert.New(t)
+
+	data := NewSeriesDataFromValues([]float64{
+		nullValue,
+		3,
+		1,
+		5,
+		1,
+		nullValue,
+	})
+	minIndex, maxIndex := getSparklineExtremeIndexes(data)
+	assert.Equal(2, minIndex)
+	assert.Equal(3, maxIndex)
+	assert.Equal(4, getSparklineLastIndex(data))
+
+	data = NewSeriesDataFromValues([]float64{
+		nullValue,
+	})
+	minIndex, maxIndex = getSparklineExtremeIndexes(data)
+	assert.Equal(-1, minIndex)
+	assert.Equal(-1, maxIndex)
+	assert.Equal(-1, getSparklineLastIndex(data))
+}
+
+func TestSparklineChart(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		render func(*Painter) ([]byte, error)
+		result string
+	}{
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewSparklineChart(p, SparklineChartOption{
+					SeriesList: NewSeriesListDataFromValues([][]float64{
+						{
+							3,
+							5,
+							nullValue,
+							8,
+							2,
+							6,
+						},
+					}),
+					FillArea: true,
+					Sparkline: SparklineOption{
+						ShowLast: true,
+						ShowMin:  true,
+						ShowMax:  true,
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"120\" height=\"30\">\\n<path  d=\"M 3 23\nL 25 15\nL 71 3\nL 94 27\nL 117 11\nL 117 27\nL 3 27\nL 3 23\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,0.8)\"/><path  d=\"M 3 23\nL 25 15\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><path  d=\"M 71 3\nL 94 27\nL 117 11\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:none\"/><circle cx=\"94\" cy=\"27\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><circle cx=\"71\" cy=\"3\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><circle cx=\"117\" cy=\"11\" r=\"2\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/><path  d=\"\" style=\"stroke-width:1;stroke:rgba(84,112,198,1.0);fill:rgba(84,112,198,1.0)\"/></svg>",
+		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewSparklineChart(p, SparklineChartOption{
+					SeriesList: NewSeriesListDataFromValues([][]float64{
+						{
+							3,
+							-2,
+							4,
+							6,
+						},
+					}, ChartTypeBar),
+					Sparkline: SparklineOption{
+						ShowLast: true,
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"120\" height=\"30\">\\n<path  d=\"M 0 12\nL 29 12\nL 29 21\nL 0 21\nL 0 12\" style=\"stroke-width:0;stroke:none;fill:rgba(170,184,227,1.0)\"/><path  d=\"M 30 21\nL 59 21\nL 59 27\nL 30 27\nL 30 21\" style=\"stroke-width:0;stroke:none;fill:rgba(170,184,227,1.0)\"/><path  d=\"M 60 9\nL 89 9\nL 89 21\nL 60 21\nL 60 9\" style=\"stroke-width:0;stroke:none;fill:rgba(170,184,227,1.0)\"/><path  d=\"M 90 3\nL 119 3\nL 119 21\nL 90 21\nL 90 3\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/></svg>",
+		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewSparklineChart(p, SparklineChartOption{
+					SeriesList: NewSeriesListDataFromValues([][]float64{
+						{
+							3,
+							-2,
+							4,
+							6,
+						},
+					}, ChartTypeBar),
+					Sparkline: SparklineOption{
+						ShowMin:  true,
+						ShowMax:  true,
+						MaxColor: parseColor("#1890ff"),
+						MinColor: parseColor("#faad14"),
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"120\" height=\"30\">\\n<path  d=\"M 0 12\nL 29 12\nL 29 21\nL 0 21\nL 0 12\" style=\"stroke-width:0;stroke:none;fill:rgba(170,184,227,1.0)\"/><path  d=\"M 30 21\nL 59 21\nL 59 27\nL 30 27\nL 30 21\" style=\"stroke-width:0;stroke:none;fill:rgba(250,173,20,1.0)\"/><path  d=\"M 60 9\nL 89 9\nL 89 21\nL 60 21\nL 60 9\" style=\"stroke-width:0;stroke:none;fill:rgba(170,184,227,1.0)\"/><path  d=\"M 90 3\nL 119 3\nL 119 21\nL 90 21\nL 90 3\" style=\"stroke-width:0;stroke:none;fill:rgba(24,144,255,1.0)\"/></svg>",
+		},
+	}
+
+	for _, tt := range tests {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  120,
+			Height: 30,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		data, err := tt.render(p)
+		assert.Nil(err)
+		assert.Equal(tt.result, string(data))
+	}
+}
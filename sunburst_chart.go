@@ -0,0 +1,251 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"errors"
+	"math"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+type sunburstChart struct {
+	p   *Painter
+	opt *SunburstChartOption
+}
+
+// SunburstNode is the tree node of sunburst chart
+type SunburstNode = TreemapNode
+
+type SunburstOption struct {
+	// The outer radius of sunburst, default is 40%
+	Radius string
+	// The inner radius of sunburst, default is 0
+	InnerRadius string
+}
+
+type SunburstChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The tree nodes of sunburst
+	Nodes []SunburstNode
+	// The padding of sunburst chart
+	Padding Box
+	// The option of title
+	Title TitleOption
+	// The option of sunburst
+	Sunburst SunburstOption
+	// The font size of label, default is 10
+	FontSize float64
+	// background is filled
+	backgroundIsFilled bool
+}
+
+// NewSunburstChart returns a sunburst chart renderer
+func NewSunburstChart(p *Painter, opt SunburstChartOption) *sunburstChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &sunburstChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// getSunburstDepth returns the depth of the nodes whose value > 0
+func getSunburstDepth(nodes []SunburstNode) int {
+	depth := 0
+	for _, item := range sortTreemapNodes(nodes) {
+		depth = chart.MaxInt(depth, getSunburstDepth(item.node.Children)+1)
+	}
+	return depth
+}
+
+// getSunburstChildColor returns the color of child node,
+// it's lighter than the parent and varies with the index
+func getSunburstChildColor(parent Color, index, count int) Color {
+	return lightenColor(parent, 0.15+0.3*float64(index)/float64(count))
+}
+
+type sunburstRing struct {
+	cx          int
+	cy          int
+	innerRadius float64
+	ringWidth   float64
+}
+
+func (s *sunburstChart) renderNodes(p *Painter, nodes []SunburstNode, ring sunburstRing, depth int, total, startPercent, anglePercent float64, color Color) {
+	renderNodes := sortTreemapNodes(nodes)
+	// 父节点的值大于子节点之和时，子节点不占满父节点的角度，
+	// 小于子节点之和时则按子节点之和计算，避免超出父节点的角度
+	sum := 0.0
+	for _, item := range renderNodes {
+		sum += item.value
+	}
+	total = math.Max(total, sum)
+	theme := s.opt.Theme
+	innerRadius := ring.innerRadius + ring.ringWidth*float64(depth)
+	outerRadius := innerRadius + ring.ringWidth
+	for index, item := range renderNodes {
+		node := item.node
+		// 第一层的节点使用主题颜色，子节点基于父节点颜色调整亮度
+		nodeColor := theme.GetSeriesColor(index)
+		if depth != 0 {
+			nodeColor = getSunburstChildColor(color, index, len(renderNodes))
+		}
+		if !node.Color.IsZero() {
+			nodeColor = node.Color
+		}
+		percent := anglePercent * item.value / total
+		sec := newSector(ring.cx, ring.cy, outerRadius, outerRadius, item.value, percent, startPercent, percent, 0, node.Name, Series{}, nodeColor)
+		sec.innerRadius = innerRadius
+		p.OverrideDrawingStyle(Style{
+			StrokeWidth: 1,
+			StrokeColor: theme.GetBackgroundColor(),
+			FillColor:   nodeColor,
+		})
+		sec.draw(p)
+		s.renderLabel(p, node.Name, sec)
+		if len(node.Children) != 0 {
+			s.renderNodes(p, node.Children, ring, depth+1, item.value, startPercent, percent, nodeColor)
+		}
+		startPercent += percent
+	}
+}
+
+// renderLabel renders the label of sector along the radius,
+// it will be hidden if there is no space
+func (s *sunburstChart) renderLabel(p *Painter, text string, sec sector) {
+	if len(text) == 0 {
+		return
+	}
+	fontColor := defaultDarkFontColor
+	if isLightColor(sec.color) {
+		fontColor = defaultLightFontColor
+	}
+	p.OverrideTextStyle(Style{
+		FontColor: fontColor,
+		FontSize:  s.opt.FontSize,
+		Font:      s.opt.Font,
+	})
+	textBox := p.MeasureText(text)
+	textWidth := float64(textBox.Width())
+	textHeight := float64(textBox.Height())
+	midRadius := (sec.innerRadius + sec.rx) / 2
+	// 完整的圆形或圆环于顶部水平展示
+	if sec.delta >= 2*math.Pi {
+		if sec.innerRadius <= 0 {
+			midRadius = 0
+		}
+		if textWidth+2*treemapLabelPadding > math.Max(2*midRadius, sec.rx) ||
+			textHeight+2*treemapLabelPadding > sec.rx-sec.innerRadius {
+			return
+		}
+		p.Text(text, sec.cx-textBox.Width()>>1, sec.cy-int(midRadius)+textBox.Height()>>1)
+		return
+	}
+	if midRadius*math.Min(sec.delta, math.Pi) < textHeight {
+		return
+	}
+	// 超出圆环宽度时截断
+	if textWidth+2*treemapLabelPadding > sec.rx-sec.innerRadius {
+		text = truncateText(p, text, int(sec.rx-sec.innerRadius)-2*treemapLabelPadding)
+		if len(text) == 0 {
+			return
+		}
+		textWidth = float64(p.MeasureText(text).Width())
+	}
+	angle := sec.start + sec.delta/2
+	// 左半边的文本旋转180度，避免文字倒置
+	rotation := angle
+	radius := midRadius - textWidth/2
+	if math.Cos(angle) < 0 {
+		rotation = angle + math.Pi
+		radius = midRadius + textWidth/2
+	}
+	// 文本基线沿旋转后的垂直方向偏移，使其居中于扇形
+	x := float64(sec.cx) + radius*math.Cos(angle) - textHeight/2*math.Sin(rotation)
+	y := float64(sec.cy) + radius*math.Sin(angle) + textHeight/2*math.Cos(rotation)
+	p.TextRotation(text, int(math.Round(x)), int(math.Round(y)), rotation)
+}
+
+func (s *sunburstChart) render(result *defaultRenderResult, nodes []SunburstNode) (Box, error) {
+	opt := s.opt
+	if len(nodes) == 0 {
+		return BoxZero, errors.New("The nodes of sunburst should not be empty")
+	}
+	total := 0.0
+	for _, item := range sortTreemapNodes(nodes) {
+		total += item.value
+	}
+	if total <= 0 {
+		return BoxZero, errors.New("The sum value of sunburst chart should gt 0")
+	}
+	if opt.FontSize <= 0 {
+		opt.FontSize = labelFontSize
+	}
+	seriesPainter := result.seriesPainter
+	diameter := float64(chart.MinInt(seriesPainter.Width(), seriesPainter.Height()))
+	radius := getRadius(diameter, opt.Sunburst.Radius)
+	innerRadius := getInnerRadius(diameter, opt.Sunburst.InnerRadius)
+	if innerRadius >= radius {
+		innerRadius = 0
+	}
+	depth := getSunburstDepth(nodes)
+	s.renderNodes(seriesPainter, nodes, sunburstRing{
+		cx:          seriesPainter.Width() >> 1,
+		cy:          seriesPainter.Height() >> 1,
+		innerRadius: innerRadius,
+		ringWidth:   (radius - innerRadius) / float64(depth),
+	}, 0, total, 0, 1, Color{})
+	return s.p.box, nil
+}
+
+func (s *sunburstChart) Render() (Box, error) {
+	opt := s.opt
+
+	renderResult, err := defaultRender(s.p, defaultRenderOption{
+		Theme:   opt.Theme,
+		Padding: opt.Padding,
+		XAxis: XAxisOption{
+			Show: FalseFlag(),
+		},
+		YAxisOptions: []YAxisOption{
+			{
+				Show: FalseFlag(),
+			},
+		},
+		TitleOption: opt.Title,
+		LegendOption: LegendOption{
+			Show: FalseFlag(),
+		},
+		backgroundIsFilled: opt.backgroundIsFilled,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return s.render(renderResult, opt.Nodes)
+}
@@ -0,0 +1,249 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSunburstDepth(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(0, getSunburstDepth(nil))
+	assert.Equal(3, getSunburstDepth([]SunburstNode{
+		{
+			Value: 1,
+		},
+		{
+			Children: []SunburstNode{
+				{
+					Children: []SunburstNode{
+						{
+							Value: 1,
+						},
+					},
+				},
+				{
+					// 值为0的节点不计算层级
+					Children: []SunburstNode{
+						{
+							Children: []SunburstNode{
+								{
+									Value: 0,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}))
+}
+
+func TestSunburstChart(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		render func(*Painter) ([]byte, error)
+		result string
+	}{
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewSunburstChart(p, SunburstChartOption{
+					Title: TitleOption{
+						Text: "Cost",
+					},
+					Sunburst: SunburstOption{
+						Radius: "48%",
+					},
+					Nodes: []SunburstNode{
+						{
+							Name: "Tech",
+							Children: []SunburstNode{
+								{
+									Name: "Web",
+									Children: []SunburstNode{
+										{
+											Name:  "api",
+											Value: 120,
+										},
+										{
+											Name:  "db",
+											Value: 80,
+										},
+									},
+								},
+								{
+									Name:  "App",
+									Value: 60,
+								},
+							},
+						},
+						{
+							Name:  "Sales",
+							Value: 100,
+							Children: []SunburstNode{
+								{
+									Name:  "EMEA",
+									Value: 40,
+								},
+								{
+									Name:  "APAC",
+									Value: 30,
+								},
+							},
+						},
+						{
+							Name:  "HR",
+							Value: 30,
+							Color: parseColor("#bbb"),
+						},
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"0\" y=\"15\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Cost</text><path  d=\"M 300 202\nL 300 149\nA 53 53 240.00 1 1 254 228\nL 300 202\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"308\" y=\"213\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(30.00,308,213)\">Tech</text><path  d=\"M 300 95\nA 107 107 184.62 1 1 292 308\nL 296 255\nL 298 255\nL 300 255\nL 301 255\nL 303 255\nL 304 255\nL 306 255\nL 308 254\nL 310 254\nL 312 254\nL 314 253\nL 315 253\nL 317 252\nL 319 251\nL 321 251\nL 322 250\nL 324 249\nL 326 248\nL 327 247\nL 329 246\nL 330 245\nL 332 244\nL 333 243\nL 335 242\nL 336 241\nL 337 239\nL 339 238\nL 340 237\nL 341 235\nL 342 234\nL 343 232\nL 344 231\nL 345 229\nL 346 228\nL 347 226\nL 348 224\nL 349 223\nL 349 221\nL 350 219\nL 351 217\nL 351 216\nL 352 214\nL 352 212\nL 352 210\nL 353 208\nL 353 206\nL 353 205\nL 353 203\nL 353 202\nL 353 200\nL 353 198\nL 353 196\nL 352 194\nL 352 193\nL 352 191\nL 351 189\nL 351 187\nL 350 185\nL 350 184\nL 349 182\nL 348 180\nL 347 179\nL 347 177\nL 346 175\nL 345 174\nL 344 172\nL 343 171\nL 342 169\nL 340 168\nL 339 166\nL 338 165\nL 337 164\nL 335 162\nL 334 161\nL 332 160\nL 331 159\nL 329 158\nL 328 157\nL 326 156\nL 324 155\nL 323 154\nL 321 153\nL 319 153\nL 318 152\nL 316 151\nL 314 151\nL 312 150\nL 311 150\nL 309 150\nL 307 149\nL 305 149\nL 303 149\nL 301 149\nL 300 149\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(110,133,207,1.0)\"/><text x=\"367\" y=\"211\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(2.31,367,211)\">Web</text><path  d=\"M 300 42\nA 160 160 110.77 0 1 450 259\nL 400 240\nL 401 236\nL 402 233\nL 403 229\nL 404 225\nL 405 222\nL 405 218\nL 406 214\nL 406 211\nL 407 207\nL 407 203\nL 407 201\nL 407 197\nL 406 193\nL 406 190\nL 405 186\nL 405 182\nL 404 179\nL 403 175\nL 402 171\nL 401 168\nL 400 164\nL 398 161\nL 397 158\nL 395 154\nL 394 151\nL 392 148\nL 390 145\nL 388 142\nL 386 139\nL 383 136\nL 381 133\nL 379 130\nL 376 127\nL 373 125\nL 371 122\nL 368 120\nL 365 118\nL 362 115\nL 359 113\nL 356 111\nL 353 109\nL 349 108\nL 346 106\nL 343 104\nL 339 103\nL 336 102\nL 332 100\nL 329 99\nL 325 98\nL 322 98\nL 318 97\nL 314 96\nL 311 96\nL 307 96\nL 303 95\nL 300 95\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(132,151,214,1.0)\"/><text x=\"406\" y=\"136\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(-34.62,406,136)\">api</text><path  d=\"M 450 259\nA 160 160 73.85 0 1 288 362\nL 292 308\nL 296 309\nL 299 309\nL 302 309\nL 306 309\nL 310 308\nL 313 308\nL 317 307\nL 321 307\nL 324 306\nL 328 305\nL 331 304\nL 335 303\nL 338 301\nL 342 300\nL 345 298\nL 349 297\nL 352 295\nL 355 293\nL 358 291\nL 361 289\nL 364 287\nL 367 284\nL 370 282\nL 373 280\nL 376 277\nL 378 274\nL 381 271\nL 383 269\nL 385 266\nL 388 263\nL 390 259\nL 392 256\nL 393 253\nL 395 250\nL 397 246\nL 398 243\nL 400 240\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(154,170,221,1.0)\"/><text x=\"363\" y=\"312\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(57.69,363,312)\">db</text><path  d=\"M 292 308\nA 107 107 55.38 0 1 208 255\nL 254 228\nL 255 230\nL 256 231\nL 257 233\nL 258 234\nL 259 236\nL 261 237\nL 262 239\nL 263 240\nL 264 241\nL 266 242\nL 267 244\nL 269 245\nL 270 246\nL 272 247\nL 273 248\nL 275 249\nL 277 250\nL 278 250\nL 280 251\nL 282 252\nL 284 252\nL 285 253\nL 287 253\nL 289 254\nL 291 254\nL 293 255\nL 294 255\nL 296 255\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(135,155,215,1.0)\"/><text x=\"256\" y=\"283\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(302.31,256,283)\">App</text><path  d=\"M 300 202\nL 254 228\nA 53 53 92.31 0 1 276 155\nL 300 202\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"257\" y=\"196\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(16.15,257,196)\">Sales</text><path  d=\"M 208 255\nA 107 107 36.92 0 1 194 190\nL 247 196\nL 247 198\nL 247 200\nL 247 201\nL 247 202\nL 247 204\nL 247 206\nL 247 208\nL 248 210\nL 248 211\nL 248 213\nL 249 215\nL 249 217\nL 250 218\nL 250 220\nL 251 222\nL 252 223\nL 252 225\nL 253 227\nL 254 228\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(162,212,138,1.0)\"/><text x=\"205\" y=\"227\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(348.46,205,227)\">EMEA</text><path  d=\"M 194 190\nA 107 107 27.69 0 1 212 142\nL 256 172\nL 255 174\nL 254 175\nL 253 177\nL 253 178\nL 252 180\nL 251 182\nL 250 183\nL 250 185\nL 249 187\nL 249 189\nL 248 191\nL 248 192\nL 248 194\nL 247 196\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(178,219,158,1.0)\"/><text x=\"207\" y=\"173\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(20.77,207,173)\">APAC</text><path  d=\"M 300 202\nL 276 155\nA 53 53 27.69 0 1 300 149\nL 300 202\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(187,187,187,1.0)\"/><text x=\"286\" y=\"169\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(76.15,286,169)\">HR</text></svg>",
+		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewSunburstChart(p, SunburstChartOption{
+					Sunburst: SunburstOption{
+						InnerRadius: "10%",
+					},
+					Nodes: []SunburstNode{
+						{
+							Name: "All",
+							Children: []SunburstNode{
+								{
+									Name:  "A",
+									Value: 3,
+								},
+								{
+									Name:  "B",
+									Value: 1,
+								},
+							},
+						},
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 300 93\nA 92 92 180.00 0 1 300 277\nL 300 277\nA 92 92 180.00 0 1 300 93\nL 300 148\nL 299 149\nL 298 149\nL 297 149\nL 295 149\nL 294 149\nL 293 149\nL 292 150\nL 290 150\nL 289 150\nL 288 151\nL 287 151\nL 285 152\nL 284 152\nL 283 153\nL 282 153\nL 281 154\nL 280 155\nL 279 156\nL 278 156\nL 277 157\nL 276 158\nL 275 159\nL 274 160\nL 273 161\nL 272 162\nL 271 163\nL 271 164\nL 270 165\nL 269 166\nL 268 167\nL 268 168\nL 267 169\nL 267 170\nL 266 172\nL 266 173\nL 265 174\nL 265 175\nL 265 177\nL 264 178\nL 264 179\nL 264 180\nL 264 182\nL 264 183\nL 264 184\nL 263 185\nL 264 186\nL 264 187\nL 264 188\nL 264 190\nL 264 191\nL 264 192\nL 265 193\nL 265 195\nL 265 196\nL 266 197\nL 266 198\nL 267 200\nL 267 201\nL 268 202\nL 268 203\nL 269 204\nL 270 205\nL 271 206\nL 271 207\nL 272 208\nL 273 209\nL 274 210\nL 275 211\nL 276 212\nL 277 213\nL 278 214\nL 279 214\nL 280 215\nL 281 216\nL 282 217\nL 283 217\nL 284 218\nL 285 218\nL 287 219\nL 288 219\nL 289 220\nL 290 220\nL 292 220\nL 293 221\nL 294 221\nL 295 221\nL 297 221\nL 298 221\nL 299 221\nL 300 222\nL 301 221\nL 302 221\nL 303 221\nL 305 221\nL 306 221\nL 307 221\nL 308 220\nL 310 220\nL 311 220\nL 312 219\nL 313 219\nL 315 218\nL 316 218\nL 317 217\nL 318 217\nL 319 216\nL 320 215\nL 321 214\nL 322 214\nL 323 213\nL 324 212\nL 325 211\nL 326 210\nL 327 209\nL 328 208\nL 329 207\nL 329 206\nL 330 205\nL 331 204\nL 332 203\nL 332 202\nL 333 201\nL 333 200\nL 334 198\nL 334 197\nL 335 196\nL 335 195\nL 335 193\nL 336 192\nL 336 191\nL 336 190\nL 336 188\nL 336 187\nL 336 186\nL 337 185\nL 336 184\nL 336 183\nL 336 182\nL 336 180\nL 336 179\nL 336 178\nL 335 177\nL 335 175\nL 335 174\nL 334 173\nL 334 172\nL 333 170\nL 333 169\nL 332 168\nL 332 167\nL 331 166\nL 330 165\nL 329 164\nL 329 163\nL 328 162\nL 327 161\nL 326 160\nL 325 159\nL 324 158\nL 323 157\nL 322 156\nL 321 156\nL 320 155\nL 319 154\nL 318 153\nL 317 153\nL 316 152\nL 315 152\nL 313 151\nL 312 151\nL 311 150\nL 310 150\nL 308 150\nL 307 149\nL 306 149\nL 305 149\nL 303 149\nL 302 149\nL 301 149\nL 300 148\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"292\" y=\"127\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">All</text><path  d=\"M 300 37\nA 148 148 270.00 1 1 152 185\nL 208 185\nL 208 188\nL 208 191\nL 209 194\nL 209 197\nL 209 201\nL 210 204\nL 211 207\nL 212 210\nL 213 213\nL 214 216\nL 215 219\nL 216 222\nL 217 225\nL 219 228\nL 220 231\nL 222 234\nL 224 236\nL 226 239\nL 228 241\nL 230 244\nL 232 246\nL 234 249\nL 236 251\nL 239 253\nL 241 255\nL 244 257\nL 246 259\nL 249 261\nL 251 263\nL 254 265\nL 257 266\nL 260 268\nL 263 269\nL 266 270\nL 269 271\nL 272 272\nL 275 273\nL 278 274\nL 281 275\nL 284 276\nL 288 276\nL 291 276\nL 294 277\nL 297 277\nL 300 277\nL 303 277\nL 306 277\nL 309 276\nL 312 276\nL 316 276\nL 319 275\nL 322 274\nL 325 273\nL 328 272\nL 331 271\nL 334 270\nL 337 269\nL 340 268\nL 343 266\nL 346 265\nL 349 263\nL 351 261\nL 354 259\nL 356 257\nL 359 255\nL 361 253\nL 364 251\nL 366 249\nL 368 246\nL 370 244\nL 372 241\nL 374 239\nL 376 236\nL 378 234\nL 380 231\nL 381 228\nL 383 225\nL 384 222\nL 385 219\nL 386 216\nL 387 213\nL 388 210\nL 389 207\nL 390 204\nL 391 201\nL 391 197\nL 391 194\nL 392 191\nL 392 188\nL 392 185\nL 392 182\nL 392 179\nL 391 176\nL 391 173\nL 391 169\nL 390 166\nL 389 163\nL 388 160\nL 387 157\nL 386 154\nL 385 151\nL 384 148\nL 383 145\nL 381 142\nL 380 139\nL 378 136\nL 376 134\nL 374 131\nL 372 129\nL 370 126\nL 368 124\nL 366 121\nL 364 119\nL 361 117\nL 359 115\nL 356 113\nL 354 111\nL 351 109\nL 349 107\nL 346 105\nL 343 104\nL 340 102\nL 337 101\nL 334 100\nL 331 99\nL 328 98\nL 325 97\nL 322 96\nL 319 95\nL 316 94\nL 312 94\nL 309 94\nL 306 93\nL 303 93\nL 300 93\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(110,133,207,1.0)\"/><text x=\"378\" y=\"271\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(45.00,378,271)\">A</text><path  d=\"M 152 185\nA 148 148 90.00 0 1 300 37\nL 300 93\nL 297 93\nL 294 93\nL 291 94\nL 288 94\nL 284 94\nL 281 95\nL 278 96\nL 275 97\nL 272 98\nL 269 99\nL 266 100\nL 263 101\nL 260 102\nL 257 104\nL 254 105\nL 251 107\nL 249 109\nL 246 111\nL 244 113\nL 241 115\nL 239 117\nL 236 119\nL 234 121\nL 232 124\nL 230 126\nL 228 129\nL 226 131\nL 224 134\nL 222 136\nL 220 139\nL 219 142\nL 217 145\nL 216 148\nL 215 151\nL 214 154\nL 213 157\nL 212 160\nL 211 163\nL 210 166\nL 209 169\nL 209 173\nL 209 176\nL 208 179\nL 208 182\nL 208 185\nZ\" style=\"stroke-width:1;stroke:rgba(255,255,255,1.0);fill:rgba(135,155,215,1.0)\"/><text x=\"208\" y=\"101\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\" transform=\"rotate(45.00,208,101)\">B</text></svg>",
+		},
+	}
+
+	for _, tt := range tests {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		data, err := tt.render(p)
+		assert.Nil(err)
+		assert.Equal(tt.result, string(data))
+	}
+}
+
+func TestSunburstChartZeroInnerRadius(t *testing.T) {
+	assert := assert.New(t)
+
+	render := func(innerRadius string) string {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		_, err = NewSunburstChart(p, SunburstChartOption{
+			Sunburst: SunburstOption{
+				Radius:      "45%",
+				InnerRadius: innerRadius,
+			},
+			Nodes: []SunburstNode{
+				{
+					Name:  "A",
+					Value: 10,
+				},
+				{
+					Name:  "B",
+					Value: 20,
+				},
+			},
+		}).Render()
+		assert.Nil(err)
+		data, err := p.Bytes()
+		assert.Nil(err)
+		return string(data)
+	}
+	// 内半径为0时不展示空心
+	assert.Equal(render(""), render("0%"))
+	assert.Equal(render(""), render("0"))
+	assert.NotEqual(render(""), render("10%"))
+}
+
+func TestSunburstChartChildrenOverflow(t *testing.T) {
+	assert := assert.New(t)
+
+	render := func(value float64) string {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		_, err = NewSunburstChart(p, SunburstChartOption{
+			Nodes: []SunburstNode{
+				{
+					Name:  "A",
+					Value: value,
+					Children: []SunburstNode{
+						{
+							Name:  "A1",
+							Value: 8,
+						},
+						{
+							Name:  "A2",
+							Value: 8,
+						},
+					},
+				},
+			},
+		}).Render()
+		assert.Nil(err)
+		data, err := p.Bytes()
+		assert.Nil(err)
+		return string(data)
+	}
+	// 父节点的值小于子节点之和时，子节点按其和占满父节点的角度
+	assert.Equal(render(0), render(10))
+	assert.NotEqual(render(0), render(32))
+}
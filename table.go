@@ -93,6 +93,10 @@ type TableChartOption struct {
 	CellTextStyle func(TableCell) *Style
 	// CellStyle customize drawing style of table cell
 	CellStyle func(TableCell) *Style
+	// CellSparkline returns the sparkline of table cell, it's drawn instead of the text
+	CellSparkline func(TableCell) *SparklineChartOption
+	// The height of sparkline in table cell, default is 20
+	SparklineHeight int
 }
 
 type TableSetting struct {
@@ -187,6 +191,8 @@ var TableDarkThemeSetting = TableSetting{
 
 var tableDefaultSetting = TableLightThemeSetting
 
+const defaultTableSparklineHeight = 20
+
 // SetDefaultTableSetting sets the default setting for table
 func SetDefaultTableSetting(setting TableSetting) {
 	tableDefaultSetting = setting
@@ -273,6 +279,16 @@ func (t *tableChart) render() (*renderInfo, error) {
 			return nil
 		}
 	}
+	getCellSparkline := opt.CellSparkline
+	if getCellSparkline == nil {
+		getCellSparkline = func(_ TableCell) *SparklineChartOption {
+			return nil
+		}
+	}
+	sparklineHeight := opt.SparklineHeight
+	if sparklineHeight <= 0 {
+		sparklineHeight = defaultTableSparklineHeight
+	}
 	// textAligns := opt.TextAligns
 	getTextAlign := func(index int) string {
 		if len(opt.TextAligns) <= index {
@@ -288,37 +304,62 @@ func (t *tableChart) render() (*renderInfo, error) {
 		textList []string,
 		currentHeight int,
 		cellPadding Box,
-	) int {
+	) (int, error) {
 		cellMaxHeight := 0
 		paddingHeight := cellPadding.Top + cellPadding.Bottom
 		paddingWidth := cellPadding.Left + cellPadding.Right
 		for index, text := range textList {
-			cellStyle := getCellTextStyle(TableCell{
+			cell := TableCell{
 				Text:   text,
 				Row:    rowIndex,
 				Column: index,
 				Style:  currentStyle,
-			})
-			if cellStyle == nil {
-				cellStyle = &currentStyle
 			}
-			p.SetStyle(*cellStyle)
 			x := values[index]
 			y := currentHeight + cellPadding.Top
 			width := values[index+1] - x
 			x += cellPadding.Left
 			width -= paddingWidth
+			// 迷你图替代文本展示
+			if cellSparklineOpt := getCellSparkline(cell); cellSparklineOpt != nil {
+				// 复制后再设置主题，避免修改调用方的option
+				sparklineOpt := *cellSparklineOpt
+				if sparklineOpt.Theme == nil {
+					sparklineOpt.Theme = theme
+				}
+				_, err := NewSparklineChart(p.Child(PainterBoxOption(Box{
+					Top:    p.box.Top + y,
+					Left:   p.box.Left + x,
+					Right:  p.box.Left + x + width,
+					Bottom: p.box.Top + y + sparklineHeight,
+				})), sparklineOpt).Render()
+				if err != nil {
+					return 0, err
+				}
+				if sparklineHeight+paddingHeight > cellMaxHeight {
+					cellMaxHeight = sparklineHeight + paddingHeight
+				}
+				continue
+			}
+			cellStyle := getCellTextStyle(cell)
+			if cellStyle == nil {
+				cellStyle = &currentStyle
+			}
+			p.SetStyle(*cellStyle)
 			box := p.TextFit(text, x, y+int(fontSize), width, getTextAlign(index))
 			// 计算最高的高度
 			if box.Height()+paddingHeight > cellMaxHeight {
 				cellMaxHeight = box.Height() + paddingHeight
 			}
 		}
-		return cellMaxHeight
+		return cellMaxHeight, nil
 	}
 
 	// 表头的处理
-	headerHeight = renderTableCells(textStyle, 0, opt.Header, height, padding)
+	headerHeight, err := renderTableCells(textStyle, 0, opt.Header, height, padding)
+	if err != nil {
+		return nil, err
+	}
 	height += headerHeight
 	info.HeaderHeight = headerHeight
 
@@ -326,7 +367,10 @@ func (t *tableChart) render() (*renderInfo, error) {
 	textStyle.FontColor = fontColor
 	textStyle.FillColor = fontColor
 	for index, textList := range opt.Data {
-		cellHeight := renderTableCells(textStyle, index+1, textList, height, padding)
+		cellHeight, err := renderTableCells(textStyle, index+1, textList, height, padding)
+		if err != nil {
+			return nil, err
+		}
 		info.RowHeights = append(info.RowHeights, cellHeight)
 		height += cellHeight
 	}
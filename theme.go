@@ -50,6 +50,16 @@ type ColorPalette interface {
 	SetFont(*truetype.Font)
 }
 
+// TrendColorPalette is the optional interface of color palette for the colors of rising and falling,
+// the default colors are used if the color palette doesn't implement it.
+// All built-in themes use red for rising and green for falling
+type TrendColorPalette interface {
+	GetUpColor() Color
+	SetUpColor(Color)
+	GetDownColor() Color
+	SetDownColor(Color)
+}
+
 type themeColorPalette struct {
 	isDarkMode         bool
 	axisStrokeColor    Color
@@ -57,6 +67,8 @@ type themeColorPalette struct {
 	backgroundColor    Color
 	textColor          Color
 	seriesColors       []Color
+	upColor            Color
+	downColor          Color
 	fontSize           float64
 	font               *truetype.Font
 }
@@ -68,6 +80,10 @@ type ThemeOption struct {
 	BackgroundColor    Color
 	TextColor          Color
 	SeriesColors       []Color
+	// The color of rising candlestick
+	UpColor Color
+	// The color of falling candlestick
+	DownColor Color
 }
 
 var palettes = map[string]*themeColorPalette{}
@@ -76,6 +92,19 @@ const defaultFontSize = 12.0
 
 var defaultTheme ColorPalette
 
+var defaultUpColor = drawing.Color{
+	R: 235,
+	G: 84,
+	B: 84,
+	A: 255,
+}
+var defaultDownColor = drawing.Color{
+	R: 71,
+	G: 178,
+	B: 98,
+	A: 255,
+}
+
 var defaultLightFontColor = drawing.Color{
 	R: 70,
 	G: 70,
@@ -234,6 +263,8 @@ func init() {
 				A: 255,
 			},
 			SeriesColors: grafanaSeriesColors,
+			UpColor:      parseColor("#F2495C"),
+			DownColor:    parseColor("#73BF69"),
 		},
 	)
 	SetDefaultTheme(ThemeLight)
@@ -252,6 +283,8 @@ func AddTheme(name string, opt ThemeOption) {
 		backgroundColor:    opt.BackgroundColor,
 		textColor:          opt.TextColor,
 		seriesColors:       opt.SeriesColors,
+		upColor:            opt.UpColor,
+		downColor:          opt.DownColor,
 	}
 }
 
@@ -330,3 +363,41 @@ func (t *themeColorPalette) GetFont() *truetype.Font {
 func (t *themeColorPalette) SetFont(f *truetype.Font) {
 	t.font = f
 }
+
+func (t *themeColorPalette) GetUpColor() Color {
+	if !t.upColor.IsZero() {
+		return t.upColor
+	}
+	return defaultUpColor
+}
+
+func (t *themeColorPalette) SetUpColor(c Color) {
+	t.upColor = c
+}
+
+func (t *themeColorPalette) GetDownColor() Color {
+	if !t.downColor.IsZero() {
+		return t.downColor
+	}
+	return defaultDownColor
+}
+
+func (t *themeColorPalette) SetDownColor(c Color) {
+	t.downColor = c
+}
+
+// getUpColor returns the color of rising of theme
+func getUpColor(theme ColorPalette) Color {
+	if t, ok := theme.(TrendColorPalette); ok {
+		return t.GetUpColor()
+	}
+	return defaultUpColor
+}
+
+// getDownColor returns the color of falling of theme
+func getDownColor(theme ColorPalette) Color {
+	if t, ok := theme.(TrendColorPalette); ok {
+		return t.GetDownColor()
+	}
+	return defaultDownColor
+}
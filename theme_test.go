@@ -0,0 +1,62 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// customColorPalette only implements the methods of ColorPalette
+type customColorPalette struct {
+	ColorPalette
+}
+
+func TestTrendColor(t *testing.T) {
+	assert := assert.New(t)
+
+	theme := NewTheme(ThemeGrafana)
+	assert.Equal(parseColor("#F2495C"), getUpColor(theme))
+	assert.Equal(parseColor("#73BF69"), getDownColor(theme))
+
+	// 内置主题的上涨为红色，下跌为绿色
+	for _, name := range []string{
+		ThemeLight,
+		ThemeDark,
+		ThemeGrafana,
+		ThemeAnt,
+	} {
+		theme := NewTheme(name)
+		assert.True(getUpColor(theme).R > getUpColor(theme).G)
+		assert.True(getDownColor(theme).G > getDownColor(theme).R)
+	}
+
+	custom := customColorPalette{
+		ColorPalette: theme,
+	}
+	_, ok := interface{}(custom).(TrendColorPalette)
+	assert.False(ok)
+	assert.Equal(defaultUpColor, getUpColor(custom))
+	assert.Equal(defaultDownColor, getDownColor(custom))
+}
@@ -0,0 +1,255 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"time"
+)
+
+const (
+	timeUnitSecond = iota
+	timeUnitMinute
+	timeUnitHour
+	timeUnitDay
+	timeUnitWeek
+	timeUnitHalfMonth
+	timeUnitMonth
+	timeUnitYear
+)
+
+// 时间轴刻度的最小宽度
+const defaultTimeAxisTickWidth = 70
+
+type timeInterval struct {
+	unit int
+	step int
+}
+
+// 可选的刻度间隔，由小到大
+var timeIntervals = []timeInterval{
+	{timeUnitSecond, 1},
+	{timeUnitSecond, 5},
+	{timeUnitSecond, 15},
+	{timeUnitSecond, 30},
+	{timeUnitMinute, 1},
+	{timeUnitMinute, 5},
+	{timeUnitMinute, 15},
+	{timeUnitMinute, 30},
+	{timeUnitHour, 1},
+	{timeUnitHour, 3},
+	{timeUnitHour, 6},
+	{timeUnitHour, 12},
+	{timeUnitDay, 1},
+	{timeUnitDay, 2},
+	{timeUnitWeek, 1},
+	{timeUnitHalfMonth, 1},
+	{timeUnitMonth, 1},
+	{timeUnitMonth, 3},
+	{timeUnitMonth, 6},
+	{timeUnitYear, 1},
+	{timeUnitYear, 2},
+	{timeUnitYear, 5},
+	{timeUnitYear, 10},
+	{timeUnitYear, 20},
+	{timeUnitYear, 50},
+	{timeUnitYear, 100},
+}
+
+// duration returns the approximate duration of interval
+func (ti timeInterval) duration() time.Duration {
+	var d time.Duration
+	switch ti.unit {
+	case timeUnitSecond:
+		d = time.Second
+	case timeUnitMinute:
+		d = time.Minute
+	case timeUnitHour:
+		d = time.Hour
+	case timeUnitDay:
+		d = 24 * time.Hour
+	case timeUnitWeek:
+		d = 7 * 24 * time.Hour
+	case timeUnitHalfMonth:
+		d = 15 * 24 * time.Hour
+	case timeUnitMonth:
+		d = 30 * 24 * time.Hour
+	default:
+		d = 365 * 24 * time.Hour
+	}
+	return time.Duration(ti.step) * d
+}
+
+// truncate returns the start of interval which contains the time
+func (ti timeInterval) truncate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+	loc := t.Location()
+	step := ti.step
+	switch ti.unit {
+	case timeUnitSecond:
+		return time.Date(year, month, day, hour, minute, second-second%step, 0, loc)
+	case timeUnitMinute:
+		return time.Date(year, month, day, hour, minute-minute%step, 0, 0, loc)
+	case timeUnitHour:
+		return time.Date(year, month, day, hour-hour%step, 0, 0, 0, loc)
+	case timeUnitDay:
+		return time.Date(year, month, day, 0, 0, 0, 0, loc)
+	case timeUnitWeek:
+		// 以周一为一周的开始
+		offset := (int(t.Weekday()) + 6) % 7
+		return time.Date(year, month, day-offset, 0, 0, 0, 0, loc)
+	case timeUnitHalfMonth:
+		// 每月的1日与16日
+		if day >= 16 {
+			day = 16
+		} else {
+			day = 1
+		}
+		return time.Date(year, month, day, 0, 0, 0, 0, loc)
+	case timeUnitMonth:
+		return time.Date(year, month-(month-1)%time.Month(step), 1, 0, 0, 0, 0, loc)
+	default:
+		return time.Date(year-year%step, 1, 1, 0, 0, 0, 0, loc)
+	}
+}
+
+// next returns the start of next interval
+func (ti timeInterval) next(t time.Time) time.Time {
+	year, month, day := t.Date()
+	loc := t.Location()
+	step := ti.step
+	switch ti.unit {
+	case timeUnitDay:
+		return time.Date(year, month, day+step, 0, 0, 0, 0, loc)
+	case timeUnitWeek:
+		return time.Date(year, month, day+7*step, 0, 0, 0, 0, loc)
+	case timeUnitHalfMonth:
+		if day < 16 {
+			return time.Date(year, month, 16, 0, 0, 0, 0, loc)
+		}
+		return time.Date(year, month+1, 1, 0, 0, 0, 0, loc)
+	case timeUnitMonth:
+		return time.Date(year, month+time.Month(step), 1, 0, 0, 0, 0, loc)
+	case timeUnitYear:
+		return time.Date(year+step, 1, 1, 0, 0, 0, 0, loc)
+	default:
+		// 小于一天的按时长计算，避免夏令时切换时重复
+		return t.Add(ti.duration())
+	}
+}
+
+// layout returns the layout of time label,
+// it shows the date at the beginning of day
+func (ti timeInterval) layout(t time.Time) string {
+	hour, minute, second := t.Clock()
+	isStartOfDay := hour == 0 && minute == 0 && second == 0
+	switch ti.unit {
+	case timeUnitSecond:
+		if isStartOfDay {
+			return "01-02"
+		}
+		return "15:04:05"
+	case timeUnitMinute, timeUnitHour:
+		if isStartOfDay {
+			return "01-02"
+		}
+		return "15:04"
+	case timeUnitDay, timeUnitWeek, timeUnitHalfMonth:
+		return "01-02"
+	case timeUnitMonth:
+		return "2006-01"
+	default:
+		return "2006"
+	}
+}
+
+type timeAxisTick struct {
+	value time.Time
+	label string
+}
+
+// getTimeInterval returns the smallest interval which divides
+// the duration into no more than count parts
+func getTimeInterval(d time.Duration, count int) timeInterval {
+	if count < 1 {
+		count = 1
+	}
+	for _, ti := range timeIntervals {
+		// 使用float避免溢出
+		if d.Seconds() <= ti.duration().Seconds()*float64(count) {
+			return ti
+		}
+	}
+	return timeIntervals[len(timeIntervals)-1]
+}
+
+// getTimeAxisTicks returns the ticks between min and max time,
+// the ticks are aligned to the interval in the location
+func getTimeAxisTicks(min, max time.Time, count int, loc *time.Location, layout string) []timeAxisTick {
+	if loc == nil {
+		loc = min.Location()
+	}
+	min = min.In(loc)
+	max = max.In(loc)
+	ti := getTimeInterval(max.Sub(min), count)
+
+	ticks := make([]timeAxisTick, 0)
+	t := ti.truncate(min)
+	for !t.After(max) {
+		if !t.Before(min) {
+			tickLayout := layout
+			if tickLayout == "" {
+				tickLayout = ti.layout(t)
+			}
+			ticks = append(ticks, timeAxisTick{
+				value: t,
+				label: t.Format(tickLayout),
+			})
+		}
+		t = ti.next(t)
+	}
+	return ticks
+}
+
+// timeToValue converts time to unix milliseconds for axis range
+func timeToValue(t time.Time) float64 {
+	return float64(t.UnixNano()) / float64(time.Millisecond)
+}
+
+// newTimeAxisRange returns the range and ticks of time axis
+func newTimeAxisRange(p *Painter, opt XAxisOption, seriesList SeriesList, size int) (axisRange, []timeAxisTick) {
+	max, min := seriesList.GetTimeMaxMin()
+	count := opt.SplitNumber
+	if count <= 0 {
+		count = size / defaultTimeAxisTickWidth
+	}
+	ticks := getTimeAxisTicks(min, max, count, opt.Location, opt.TimeLayout)
+	r := axisRange{
+		p:           p,
+		divideCount: len(ticks),
+		min:         timeToValue(min),
+		max:         timeToValue(max),
+		size:        size,
+	}
+	return r, ticks
+}
@@ -0,0 +1,111 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTimeInterval(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(timeInterval{timeUnitMinute, 15}, getTimeInterval(time.Hour, 6))
+	assert.Equal(timeInterval{timeUnitHour, 12}, getTimeInterval(3*24*time.Hour, 6))
+	assert.Equal(timeInterval{timeUnitHalfMonth, 1}, getTimeInterval(50*24*time.Hour, 6))
+	assert.Equal(timeInterval{timeUnitMonth, 3}, getTimeInterval(365*24*time.Hour, 6))
+	assert.Equal(timeInterval{timeUnitYear, 50}, getTimeInterval(250*365*24*time.Hour, 6))
+	assert.Equal(timeInterval{timeUnitYear, 100}, getTimeInterval(250*365*24*time.Hour, 1))
+}
+
+func TestGetTimeAxisTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	getLabels := func(ticks []timeAxisTick) []string {
+		labels := make([]string, len(ticks))
+		for index, tick := range ticks {
+			labels[index] = tick.label
+		}
+		return labels
+	}
+
+	start := time.Date(2022, 3, 1, 21, 13, 0, 0, time.UTC)
+	ticks := getTimeAxisTicks(start, start.Add(3*time.Hour), 6, nil, "")
+	assert.Equal([]string{
+		"21:30",
+		"22:00",
+		"22:30",
+		"23:00",
+		"23:30",
+		"03-02",
+	}, getLabels(ticks))
+	assert.Equal(time.Date(2022, 3, 1, 21, 30, 0, 0, time.UTC), ticks[0].value)
+
+	// 按时区对齐
+	ticks = getTimeAxisTicks(start, start.Add(3*time.Hour), 6, time.FixedZone("UTC+8", 8*3600), "")
+	assert.Equal([]string{
+		"05:30",
+		"06:00",
+		"06:30",
+		"07:00",
+		"07:30",
+		"08:00",
+	}, getLabels(ticks))
+
+	ticks = getTimeAxisTicks(start, start.Add(50*24*time.Hour), 6, nil, "")
+	assert.Equal([]string{
+		"03-16",
+		"04-01",
+		"04-16",
+	}, getLabels(ticks))
+
+	ticks = getTimeAxisTicks(start, start.Add(10*24*time.Hour), 2, nil, "2006/01/02")
+	assert.Equal([]string{
+		"2022/03/07",
+	}, getLabels(ticks))
+}
+
+func TestSeriesListGetTimeMaxMin(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	seriesList := SeriesList{
+		{
+			Data: NewSeriesDataFromTimeValues([]time.Time{
+				start.Add(time.Hour),
+				start,
+				start.Add(3 * time.Hour),
+			}, []float64{
+				1,
+				2,
+				nullValue,
+			}),
+		},
+	}
+	max, min := seriesList.GetTimeMaxMin()
+	assert.Equal(start.Add(time.Hour), max)
+	assert.Equal(start, min)
+	assert.Equal(float64(start.Unix()*1000), timeToValue(start))
+}
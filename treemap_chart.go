@@ -0,0 +1,389 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+type treemapChart struct {
+	p   *Painter
+	opt *TreemapChartOption
+	// The height of one line label
+	labelHeight int
+}
+
+type TreemapNode struct {
+	// The name of node
+	Name string
+	// The value of node, it's the sum value of children if it's not set
+	Value float64
+	// The color of node, default is the color of top level node
+	Color Color
+	// The children of node
+	Children []TreemapNode
+}
+
+// GetValue returns the value of node, it's the sum value of children if the value is not set
+func (n *TreemapNode) GetValue() float64 {
+	if n.Value > 0 || len(n.Children) == 0 {
+		return n.Value
+	}
+	value := 0.0
+	for index := range n.Children {
+		value += math.Max(n.Children[index].GetValue(), 0)
+	}
+	return value
+}
+
+type TreemapChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The tree nodes of treemap
+	Nodes []TreemapNode
+	// The padding of treemap chart
+	Padding Box
+	// The option of title
+	Title TitleOption
+	// The font size of label, default is the font size of theme
+	FontSize float64
+	// background is filled
+	backgroundIsFilled bool
+}
+
+// NewTreemapChart returns a treemap chart renderer
+func NewTreemapChart(p *Painter, opt TreemapChartOption) *treemapChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &treemapChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+type treemapRect struct {
+	x      float64
+	y      float64
+	width  float64
+	height float64
+}
+
+func (r treemapRect) box() Box {
+	return Box{
+		Left:   int(math.Round(r.x)),
+		Top:    int(math.Round(r.y)),
+		Right:  int(math.Round(r.x + r.width)),
+		Bottom: int(math.Round(r.y + r.height)),
+	}
+}
+
+// getTreemapWorstRatio returns the worst aspect ratio of row laid out along the side
+func getTreemapWorstRatio(row []float64, side float64) float64 {
+	sum := 0.0
+	max := 0.0
+	min := math.MaxFloat64
+	for _, v := range row {
+		sum += v
+		max = math.Max(max, v)
+		min = math.Min(min, v)
+	}
+	side2 := side * side
+	sum2 := sum * sum
+	return math.Max(side2*max/sum2, sum2/(side2*min))
+}
+
+// squarify lays out the values (sorted descending) in rect by squarified algorithm,
+// it returns the rect of each value
+func squarify(values []float64, rect treemapRect) []treemapRect {
+	result := make([]treemapRect, 0, len(values))
+	total := 0.0
+	for _, v := range values {
+		total += v
+	}
+	if total <= 0 {
+		return result
+	}
+	// 按面积缩放
+	scale := rect.width * rect.height / total
+	areas := make([]float64, len(values))
+	for index, v := range values {
+		areas[index] = v * scale
+	}
+	x := rect.x
+	y := rect.y
+	width := rect.width
+	height := rect.height
+	for len(areas) != 0 {
+		side := math.Min(width, height)
+		count := 1
+		for count < len(areas) &&
+			getTreemapWorstRatio(areas[:count+1], side) <= getTreemapWorstRatio(areas[:count], side) {
+			count++
+		}
+		row := areas[:count]
+		sum := 0.0
+		for _, v := range row {
+			sum += v
+		}
+		if width >= height {
+			// 沿左侧纵向排列
+			rowWidth := sum / height
+			offset := y
+			for _, v := range row {
+				h := v / rowWidth
+				result = append(result, treemapRect{
+					x:      x,
+					y:      offset,
+					width:  rowWidth,
+					height: h,
+				})
+				offset += h
+			}
+			x += rowWidth
+			width -= rowWidth
+		} else {
+			// 沿顶部横向排列
+			rowHeight := sum / width
+			offset := x
+			for _, v := range row {
+				w := v / rowHeight
+				result = append(result, treemapRect{
+					x:      offset,
+					y:      y,
+					width:  w,
+					height: rowHeight,
+				})
+				offset += w
+			}
+			y += rowHeight
+			height -= rowHeight
+		}
+		areas = areas[count:]
+	}
+	return result
+}
+
+type treemapRenderNode struct {
+	node  *TreemapNode
+	value float64
+}
+
+// sortTreemapNodes returns the nodes whose value > 0, sorted by value descending
+func sortTreemapNodes(nodes []TreemapNode) []treemapRenderNode {
+	result := make([]treemapRenderNode, 0, len(nodes))
+	for index := range nodes {
+		value := nodes[index].GetValue()
+		if value <= 0 {
+			continue
+		}
+		result = append(result, treemapRenderNode{
+			node:  &nodes[index],
+			value: value,
+		})
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].value > result[j].value
+	})
+	return result
+}
+
+const treemapLabelPadding = 4
+
+func (t *treemapChart) renderNodes(p *Painter, nodes []TreemapNode, rect treemapRect, depth int, color Color) {
+	renderNodes := sortTreemapNodes(nodes)
+	values := make([]float64, len(renderNodes))
+	for index, item := range renderNodes {
+		values[index] = item.value
+	}
+	theme := t.opt.Theme
+	for index, r := range squarify(values, rect) {
+		node := renderNodes[index].node
+		nodeColor := color
+		// 第一层的节点使用主题颜色
+		if depth == 0 {
+			nodeColor = theme.GetSeriesColor(index)
+		}
+		if !node.Color.IsZero() {
+			nodeColor = node.Color
+		}
+		box := r.box()
+		if box.Width() <= 0 || box.Height() <= 0 {
+			continue
+		}
+		// 使用背景色描边，层级越高间隔越大
+		borderWidth := math.Max(3-float64(depth), 1)
+		p.OverrideDrawingStyle(Style{
+			StrokeWidth: borderWidth,
+			StrokeColor: theme.GetBackgroundColor(),
+			FillColor:   nodeColor,
+		}).Rect(box)
+		if len(node.Children) == 0 {
+			t.renderLabel(p, node.Name, box, nodeColor)
+			continue
+		}
+		// 父节点于顶部展示名称
+		headerHeight := t.labelHeight + 2*treemapLabelPadding
+		gap := borderWidth + 1
+		childRect := treemapRect{
+			x:      r.x + gap,
+			y:      r.y + gap,
+			width:  r.width - 2*gap,
+			height: r.height - 2*gap,
+		}
+		if box.Height() > 2*headerHeight {
+			t.renderLabel(p, node.Name, Box{
+				Left:   box.Left,
+				Top:    box.Top,
+				Right:  box.Right,
+				Bottom: box.Top + headerHeight,
+			}, nodeColor)
+			childRect.y = r.y + float64(headerHeight)
+			childRect.height = r.height - float64(headerHeight) - gap
+		}
+		if childRect.width <= 0 || childRect.height <= 0 {
+			continue
+		}
+		t.renderNodes(p, node.Children, childRect, depth+1, nodeColor)
+	}
+}
+
+// renderLabel renders the label in box, the lines out of box are clipped,
+// and it will be hidden if there is no space for one line
+func (t *treemapChart) renderLabel(p *Painter, text string, box Box, color Color) {
+	opt := t.opt
+	width := box.Width() - 2*treemapLabelPadding
+	height := box.Height() - 2*treemapLabelPadding
+	words := strings.Fields(text)
+	if len(words) == 0 || width <= 0 || height < t.labelHeight {
+		return
+	}
+	fontColor := defaultDarkFontColor
+	if isLightColor(color) {
+		fontColor = defaultLightFontColor
+	}
+	style := Style{
+		FontColor: fontColor,
+		FontSize:  opt.FontSize,
+		Font:      opt.Font,
+	}
+	if style.Font == nil {
+		style.Font = p.font
+	}
+	// 计算可展示的行
+	lines := chart.Text.WrapFit(p.render, text, width, chart.Style{
+		FontSize: style.FontSize,
+		Font:     style.Font,
+		TextWrap: chart.TextWrapWord,
+	})
+	p.SetStyle(style)
+	lineSpacing := style.GetTextLineSpacing()
+	lineHeight := 0
+	wordIndex := 0
+	showLines := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+		if lineHeight+t.labelHeight > height {
+			break
+		}
+		// 单词过长会被拆分，此时不再展示
+		complete := true
+		for _, word := range strings.Fields(line) {
+			if wordIndex >= len(words) || words[wordIndex] != word {
+				complete = false
+				break
+			}
+			wordIndex++
+		}
+		if !complete {
+			break
+		}
+		lineHeight += t.labelHeight + lineSpacing
+		showLines = append(showLines, line)
+	}
+	if len(showLines) == 0 {
+		// 第一个单词无法展示时，截断并以省略号结尾
+		str := truncateText(p, words[0], width)
+		if len(str) == 0 {
+			return
+		}
+		showLines = append(showLines, str)
+	}
+	p.TextFit(strings.Join(showLines, " "), box.Left+treemapLabelPadding, box.Top+treemapLabelPadding+t.labelHeight, width)
+}
+
+func (t *treemapChart) render(result *defaultRenderResult, nodes []TreemapNode) (Box, error) {
+	if len(nodes) == 0 {
+		return BoxZero, errors.New("The nodes of treemap should not be empty")
+	}
+	if t.opt.FontSize <= 0 {
+		t.opt.FontSize = t.opt.Theme.GetFontSize()
+	}
+	seriesPainter := result.seriesPainter
+	seriesPainter.OverrideTextStyle(Style{
+		FontSize: t.opt.FontSize,
+		Font:     t.opt.Font,
+	})
+	t.labelHeight = seriesPainter.MeasureText("Hg").Height()
+	t.renderNodes(seriesPainter, nodes, treemapRect{
+		width:  float64(seriesPainter.Width()),
+		height: float64(seriesPainter.Height()),
+	}, 0, Color{})
+	return t.p.box, nil
+}
+
+func (t *treemapChart) Render() (Box, error) {
+	opt := t.opt
+
+	renderResult, err := defaultRender(t.p, defaultRenderOption{
+		Theme:   opt.Theme,
+		Padding: opt.Padding,
+		XAxis: XAxisOption{
+			Show: FalseFlag(),
+		},
+		YAxisOptions: []YAxisOption{
+			{
+				Show: FalseFlag(),
+			},
+		},
+		TitleOption: opt.Title,
+		LegendOption: LegendOption{
+			Show: FalseFlag(),
+		},
+		backgroundIsFilled: opt.backgroundIsFilled,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return t.render(renderResult, opt.Nodes)
+}
@@ -0,0 +1,183 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTreemapNodeGetValue(t *testing.T) {
+	assert := assert.New(t)
+
+	node := TreemapNode{
+		Children: []TreemapNode{
+			{
+				Value: 10,
+			},
+			{
+				Children: []TreemapNode{
+					{
+						Value: 3,
+					},
+					{
+						Value: 2,
+					},
+				},
+			},
+			{
+				Value: -1,
+			},
+		},
+	}
+	assert.Equal(15.0, node.GetValue())
+
+	node.Value = 20
+	assert.Equal(20.0, node.GetValue())
+}
+
+func TestSquarify(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]treemapRect{}, squarify([]float64{
+		0,
+	}, treemapRect{
+		width:  60,
+		height: 40,
+	}))
+
+	assert.Equal([]treemapRect{
+		{
+			x:      0,
+			y:      0,
+			width:  30,
+			height: 40,
+		},
+		{
+			x:      30,
+			y:      0,
+			width:  30,
+			height: 20,
+		},
+		{
+			x:      30,
+			y:      20,
+			width:  30,
+			height: 20,
+		},
+	}, squarify([]float64{
+		6,
+		3,
+		3,
+	}, treemapRect{
+		width:  60,
+		height: 40,
+	}))
+}
+
+func TestTreemapChart(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		render func(*Painter) ([]byte, error)
+		result string
+	}{
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewTreemapChart(p, TreemapChartOption{
+					Title: TitleOption{
+						Text: "Disk Usage",
+					},
+					Nodes: []TreemapNode{
+						{
+							Name: "src",
+							Children: []TreemapNode{
+								{
+									Name:  "charts",
+									Value: 40,
+								},
+								{
+									Name:  "examples",
+									Value: 20,
+								},
+							},
+						},
+						{
+							Name:  "assets",
+							Value: 30,
+						},
+						{
+							Name:  "docs",
+							Value: 10,
+							Color: parseColor("#bbb"),
+						},
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"0\" y=\"15\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Disk Usage</text><path  d=\"M 0 35\nL 360 35\nL 360 370\nL 0 370\nL 0 35\" style=\"stroke-width:3;stroke:rgba(255,255,255,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"4\" y=\"54\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">src</text><path  d=\"M 4 58\nL 239 58\nL 239 366\nL 4 366\nL 4 58\" style=\"stroke-width:2;stroke:rgba(255,255,255,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"8\" y=\"77\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">charts</text><path  d=\"M 239 58\nL 356 58\nL 356 366\nL 239 366\nL 239 58\" style=\"stroke-width:2;stroke:rgba(255,255,255,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"243\" y=\"77\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">examples</text><path  d=\"M 360 35\nL 600 35\nL 600 286\nL 360 286\nL 360 35\" style=\"stroke-width:3;stroke:rgba(255,255,255,1.0);fill:rgba(145,204,117,1.0)\"/><text x=\"364\" y=\"54\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">assets</text><path  d=\"M 360 286\nL 600 286\nL 600 370\nL 360 370\nL 360 286\" style=\"stroke-width:3;stroke:rgba(255,255,255,1.0);fill:rgba(187,187,187,1.0)\"/><text x=\"364\" y=\"305\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">docs</text></svg>",
+		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewTreemapChart(p, TreemapChartOption{
+					FontSize: 10,
+					Nodes: []TreemapNode{
+						{
+							Name:  "a very long label of node",
+							Value: 90,
+						},
+						{
+							Name:  "truncated",
+							Value: 1,
+						},
+						{
+							Name:  "ignored",
+							Value: 0,
+						},
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><path  d=\"M 0 0\nL 593 0\nL 593 370\nL 0 370\nL 0 0\" style=\"stroke-width:3;stroke:rgba(255,255,255,1.0);fill:rgba(84,112,198,1.0)\"/><text x=\"4\" y=\"16\" style=\"stroke-width:0;stroke:none;fill:rgba(238,238,238,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">a very long label of node</text><path  d=\"M 593 0\nL 600 0\nL 600 370\nL 593 370\nL 593 0\" style=\"stroke-width:3;stroke:rgba(255,255,255,1.0);fill:rgba(145,204,117,1.0)\"/></svg>",
+		},
+	}
+
+	for _, tt := range tests {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		data, err := tt.render(p)
+		assert.Nil(err)
+		assert.Equal(tt.result, string(data))
+	}
+}
@@ -138,6 +138,12 @@ func reverseIntSlice(intList []int) {
 	}
 }
 
+func reversePointSlice(points []Point) {
+	for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+		points[i], points[j] = points[j], points[i]
+	}
+}
+
 func convertPercent(value string) float64 {
 	if !strings.HasSuffix(value, "%") {
 		return -1
@@ -161,6 +167,11 @@ func NewFloatPoint(f float64) *float64 {
 	return &v
 }
 
+func NewIntPoint(i int) *int {
+	v := i
+	return &v
+}
+
 const K_VALUE = float64(1000)
 const M_VALUE = K_VALUE * K_VALUE
 const G_VALUE = M_VALUE * K_VALUE
@@ -183,6 +194,29 @@ func commafWithDigits(value float64) string {
 	return humanize.CommafWithDigits(value, decimals)
 }
 
+// getDecimals returns the count of decimals for showing the value exactly,
+// it's no more than 10
+func getDecimals(value float64) int {
+	for decimals := 0; decimals < 10; decimals++ {
+		v := math.Abs(value) * math.Pow10(decimals)
+		if math.Abs(v-math.Round(v)) < 1e-6 {
+			return decimals
+		}
+	}
+	return 10
+}
+
+// formatFloatWithDecimals formats the value with fixed decimals
+func formatFloatWithDecimals(value float64, decimals int) string {
+	unit := math.Pow10(decimals)
+	value = math.Round(value*unit) / unit
+	// 避免展示-0
+	if value == 0 {
+		value = 0
+	}
+	return strconv.FormatFloat(value, 'f', decimals, 64)
+}
+
 func parseColor(color string) Color {
 	c := Color{}
 	if color == "" {
@@ -222,6 +256,16 @@ func parseColor(color string) Color {
 const defaultRadiusPercent = 0.4
 
 func getRadius(diameter float64, radiusValue string) float64 {
+	radius := getInnerRadius(diameter, radiusValue)
+	if radius <= 0 {
+		radius = float64(diameter) * defaultRadiusPercent
+	}
+	return radius
+}
+
+// getInnerRadius returns the radius without default value,
+// it's 0 if the value is empty or invalid
+func getInnerRadius(diameter float64, radiusValue string) float64 {
 	var radius float64
 	if len(radiusValue) != 0 {
 		v := convertPercent(radiusValue)
@@ -231,21 +275,24 @@ func getRadius(diameter float64, radiusValue string) float64 {
 			radius, _ = strconv.ParseFloat(radiusValue, 64)
 		}
 	}
-	if radius <= 0 {
-		radius = float64(diameter) * defaultRadiusPercent
-	}
-	return radius
+	return math.Max(radius, 0)
 }
 
-func getPolygonPointAngles(sides int) []float64 {
-	angles := make([]float64, sides)
-	for i := 0; i < sides; i++ {
-		angle := 2*math.Pi/float64(sides)*float64(i) - (math.Pi / 2)
+// getPolarAngles returns the angles which divide the circle equally,
+// the first angle is the start angle and the angles increase clockwise
+func getPolarAngles(count int, startAngle float64) []float64 {
+	angles := make([]float64, count)
+	for i := 0; i < count; i++ {
+		angle := 2*math.Pi/float64(count)*float64(i) + startAngle
 		angles[i] = angle
 	}
 	return angles
 }
 
+func getPolygonPointAngles(sides int) []float64 {
+	return getPolarAngles(sides, -(math.Pi / 2))
+}
+
 func getPolygonPoint(center Point, radius, angle float64) Point {
 	x := center.X + int(radius*math.Cos(angle))
 	y := center.Y + int(radius*math.Sin(angle))
@@ -255,6 +302,43 @@ func getPolygonPoint(center Point, radius, angle float64) Point {
 	}
 }
 
+// getPolarLabelPosition returns the position of label which is outside the point,
+// the point is on the circle of center
+func getPolarLabelPosition(center, p Point, textBox Box, offset int) (int, int) {
+	isXCenter := p.X == center.X
+	isYCenter := p.Y == center.Y
+	isRight := p.X > center.X
+	isLeft := p.X < center.X
+	isTop := p.Y < center.Y
+	isBottom := p.Y > center.Y
+	x := p.X
+	y := p.Y
+	if isXCenter {
+		x -= textBox.Width() >> 1
+		if isTop {
+			y -= textBox.Height()
+		} else {
+			y += textBox.Height()
+		}
+	}
+	if isYCenter {
+		y += textBox.Height() >> 1
+	}
+	if isTop {
+		y += offset
+	}
+	if isBottom {
+		y += offset
+	}
+	if isRight {
+		x += offset
+	}
+	if isLeft {
+		x -= (textBox.Width() + offset)
+	}
+	return x, y
+}
+
 func getPolygonPoints(center Point, radius float64, sides int) []Point {
 	points := make([]Point, sides)
 	for i, angle := range getPolygonPointAngles(sides) {
@@ -269,3 +353,59 @@ func isLightColor(c Color) bool {
 	b := float64(c.B) * float64(c.B) * 0.114
 	return math.Sqrt(r+g+b) > 127.5
 }
+
+// interpolateColor returns the color of percent in the continuous colors
+func interpolateColor(colors []Color, percent float64) Color {
+	if len(colors) == 0 {
+		return Color{}
+	}
+	if len(colors) == 1 || percent <= 0 {
+		return colors[0]
+	}
+	if percent >= 1 {
+		return colors[len(colors)-1]
+	}
+	position := percent * float64(len(colors)-1)
+	index := int(position)
+	offset := position - float64(index)
+	start := colors[index]
+	end := colors[index+1]
+	mix := func(a, b uint8) uint8 {
+		return uint8(math.Round(float64(a) + (float64(b)-float64(a))*offset))
+	}
+	return Color{
+		R: mix(start.R, end.R),
+		G: mix(start.G, end.G),
+		B: mix(start.B, end.B),
+		A: mix(start.A, end.A),
+	}
+}
+
+// truncateText truncates the text and ends with ellipsis to fit the width,
+// it returns empty string if there is no space for one char
+func truncateText(p *Painter, text string, width int) string {
+	if p.MeasureText(text).Width() <= width {
+		return text
+	}
+	runes := []rune(text)
+	for i := len(runes) - 1; i > 0; i-- {
+		str := string(runes[:i]) + "..."
+		if p.MeasureText(str).Width() <= width {
+			return str
+		}
+	}
+	return ""
+}
+
+// lightenColor returns the color mixed with white by percent(0-1)
+func lightenColor(c Color, percent float64) Color {
+	return interpolateColor([]Color{
+		c,
+		{
+			R: 255,
+			G: 255,
+			B: 255,
+			A: c.A,
+		},
+	}, percent)
+}
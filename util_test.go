@@ -76,6 +76,11 @@ func TestGetRadius(t *testing.T) {
 	assert.Equal(50.0, getRadius(100, "50%"))
 	assert.Equal(30.0, getRadius(100, "30"))
 	assert.Equal(40.0, getRadius(100, ""))
+
+	assert.Equal(0.0, getInnerRadius(100, "0%"))
+	assert.Equal(0.0, getInnerRadius(100, "0"))
+	assert.Equal(0.0, getInnerRadius(100, ""))
+	assert.Equal(20.0, getInnerRadius(100, "20%"))
 }
 
 func TestMeasureTextMaxWidthHeight(t *testing.T) {
@@ -221,3 +226,67 @@ func TestIsLightColor(t *testing.T) {
 		B: 42,
 	}))
 }
+
+func TestInterpolateColor(t *testing.T) {
+	assert := assert.New(t)
+
+	colors := []Color{
+		{
+			R: 0,
+			G: 0,
+			B: 0,
+			A: 255,
+		},
+		{
+			R: 200,
+			G: 100,
+			B: 50,
+			A: 255,
+		},
+	}
+	assert.True(interpolateColor(nil, 0.5).IsZero())
+	assert.Equal(colors[0], interpolateColor(colors, -1))
+	assert.Equal(colors[1], interpolateColor(colors, 2))
+	assert.Equal(Color{
+		R: 100,
+		G: 50,
+		B: 25,
+		A: 255,
+	}, interpolateColor(colors, 0.5))
+}
+
+func TestLightenColor(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Color{
+		R: 100,
+		G: 50,
+		B: 0,
+		A: 200,
+	}
+	assert.Equal(c, lightenColor(c, 0))
+	assert.Equal(Color{
+		R: 178,
+		G: 153,
+		B: 128,
+		A: 200,
+	}, lightenColor(c, 0.5))
+	assert.Equal(Color{
+		R: 255,
+		G: 255,
+		B: 255,
+		A: 200,
+	}, lightenColor(c, 1))
+}
+
+func TestFormatFloatWithDecimals(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(0, getDecimals(20))
+	assert.Equal(1, getDecimals(0.5))
+	assert.Equal(4, getDecimals(0.0005))
+	assert.Equal(2, getDecimals(0.25))
+
+	assert.Equal("0.30", formatFloatWithDecimals(0.1+0.2, 2))
+	assert.Equal("0.0", formatFloatWithDecimals(-0.00001, 1))
+}
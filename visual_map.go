@@ -0,0 +1,215 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"github.com/golang/freetype/truetype"
+)
+
+type visualMapPainter struct {
+	p   *Painter
+	opt *VisualMapOption
+}
+
+type VisualMapOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font of visual map text
+	Font *truetype.Font
+	// The flag for show visual map, set this to *false will hide visual map
+	Show *bool
+	// The minimum value of visual map, default is the min value of series list
+	Min *float64
+	// The maximum value of visual map, default is the max value of series list
+	Max *float64
+	// The colors of continuous color scale, from min to max
+	Colors []Color
+	// The dimension of record which is mapped to color, it's used for parallel chart.
+	// The records are colored by series if it's not set
+	Dimension *int
+	// The width of color bar, default is 20
+	ItemWidth int
+	// The height of color bar, default is 140
+	ItemHeight int
+	// The font size of visual map text
+	FontSize float64
+	// The font color of visual map text
+	FontColor Color
+}
+
+const defaultVisualMapItemWidth = 20
+const defaultVisualMapItemHeight = 140
+
+var defaultVisualMapColors = []Color{
+	parseColor("#f6efa6"),
+	parseColor("#d88273"),
+	parseColor("#bf444c"),
+}
+
+// fillDefault sets the min and max value of visual map from series list if not set
+func (opt *VisualMapOption) fillDefault(seriesList SeriesList) {
+	max, min := seriesList.GetMaxMin(0)
+	if opt.Min == nil {
+		opt.Min = &min
+	}
+	if opt.Max == nil {
+		opt.Max = &max
+	}
+	if len(opt.Colors) == 0 {
+		opt.Colors = defaultVisualMapColors
+	}
+}
+
+// GetColor returns the color of value in the continuous color scale
+func (opt *VisualMapOption) GetColor(value float64) Color {
+	colors := opt.Colors
+	if len(colors) == 0 {
+		colors = defaultVisualMapColors
+	}
+	min := 0.0
+	max := 0.0
+	if opt.Min != nil {
+		min = *opt.Min
+	}
+	if opt.Max != nil {
+		max = *opt.Max
+	}
+	percent := 0.0
+	if max > min {
+		percent = (value - min) / (max - min)
+	}
+	return interpolateColor(colors, percent)
+}
+
+// NewVisualMapPainter returns a visual map renderer
+func NewVisualMapPainter(p *Painter, opt VisualMapOption) *visualMapPainter {
+	return &visualMapPainter{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+func (v *visualMapPainter) Render() (Box, error) {
+	opt := v.opt
+	if isFalse(opt.Show) {
+		return BoxZero, nil
+	}
+	theme := opt.Theme
+	if theme == nil {
+		theme = v.p.theme
+	}
+	if opt.Font == nil {
+		opt.Font = theme.GetFont()
+	}
+	if opt.FontSize == 0 {
+		opt.FontSize = theme.GetFontSize()
+	}
+	if opt.FontColor.IsZero() {
+		opt.FontColor = theme.GetTextColor()
+	}
+	itemWidth := opt.ItemWidth
+	if itemWidth <= 0 {
+		itemWidth = defaultVisualMapItemWidth
+	}
+	itemHeight := opt.ItemHeight
+	if itemHeight <= 0 {
+		itemHeight = defaultVisualMapItemHeight
+	}
+	p := v.p
+	p.OverrideTextStyle(Style{
+		Font:      opt.Font,
+		FontSize:  opt.FontSize,
+		FontColor: opt.FontColor,
+	})
+
+	formatter := commafWithDigits
+	if p.valueFormatter != nil {
+		formatter = p.valueFormatter
+	}
+	min := 0.0
+	max := 0.0
+	if opt.Min != nil {
+		min = *opt.Min
+	}
+	if opt.Max != nil {
+		max = *opt.Max
+	}
+	maxText := formatter(max)
+	minText := formatter(min)
+	maxTextBox := p.MeasureText(maxText)
+	minTextBox := p.MeasureText(minText)
+
+	textMargin := 5
+	// 与图表的间隔
+	left := 10
+	contentWidth := itemWidth
+	if maxTextBox.Width() > contentWidth {
+		contentWidth = maxTextBox.Width()
+	}
+	if minTextBox.Width() > contentWidth {
+		contentWidth = minTextBox.Width()
+	}
+	width := left + contentWidth
+
+	// 高度不足时压缩色条
+	textHeight := maxTextBox.Height() + minTextBox.Height() + 2*textMargin
+	height := p.Height() - defaultXAxisHeight
+	if itemHeight+textHeight > height {
+		itemHeight = height - textHeight
+	}
+	if itemHeight <= 0 {
+		return BoxZero, nil
+	}
+
+	barLeft := p.Width() - width + left + (contentWidth-itemWidth)>>1
+	barTop := (height-itemHeight-textHeight)>>1 + maxTextBox.Height() + textMargin
+
+	center := p.Width() - contentWidth>>1
+	p.Text(maxText, center-maxTextBox.Width()>>1, barTop-textMargin)
+	p.Text(minText, center-minTextBox.Width()>>1, barTop+itemHeight+textMargin+minTextBox.Height())
+
+	// 色条由上至下，由max至min，每2px一个色块
+	step := 2
+	for i := 0; i < itemHeight; i += step {
+		value := max - (max-min)*float64(i)/float64(itemHeight)
+		bottom := barTop + i + step
+		if bottom > barTop+itemHeight {
+			bottom = barTop + itemHeight
+		}
+		p.OverrideDrawingStyle(Style{
+			FillColor: opt.GetColor(value),
+		}).Rect(Box{
+			Top:    barTop + i,
+			Left:   barLeft,
+			Right:  barLeft + itemWidth,
+			Bottom: bottom,
+		})
+	}
+
+	return Box{
+		Top:    0,
+		Right:  p.Width(),
+		Bottom: height,
+		Left:   p.Width() - width,
+	}, nil
+}
@@ -0,0 +1,89 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVisualMapGetColor(t *testing.T) {
+	assert := assert.New(t)
+
+	opt := VisualMapOption{
+		Min: NewFloatPoint(0),
+		Max: NewFloatPoint(100),
+	}
+	assert.Equal(parseColor("#f6efa6"), opt.GetColor(0))
+	assert.Equal(parseColor("#d88273"), opt.GetColor(50))
+	assert.Equal(parseColor("#bf444c"), opt.GetColor(100))
+	assert.Equal(parseColor("#bf444c"), opt.GetColor(120))
+}
+
+func TestNewVisualMap(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		render func(*Painter) ([]byte, error)
+		result string
+	}{
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewVisualMapPainter(p, VisualMapOption{
+					Min:        NewFloatPoint(0),
+					Max:        NewFloatPoint(100),
+					ItemHeight: 10,
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<text x=\"574\" y=\"175\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><text x=\"583\" y=\"210\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path  d=\"M 576 180\nL 596 180\nL 596 182\nL 576 182\nL 576 180\" style=\"stroke-width:0;stroke:none;fill:rgba(191,68,76,1.0)\"/><path  d=\"M 576 182\nL 596 182\nL 596 184\nL 576 184\nL 576 182\" style=\"stroke-width:0;stroke:none;fill:rgba(201,93,92,1.0)\"/><path  d=\"M 576 184\nL 596 184\nL 596 186\nL 576 186\nL 576 184\" style=\"stroke-width:0;stroke:none;fill:rgba(211,118,107,1.0)\"/><path  d=\"M 576 186\nL 596 186\nL 596 188\nL 576 188\nL 576 186\" style=\"stroke-width:0;stroke:none;fill:rgba(222,152,125,1.0)\"/><path  d=\"M 576 188\nL 596 188\nL 596 190\nL 576 190\nL 576 188\" style=\"stroke-width:0;stroke:none;fill:rgba(234,195,146,1.0)\"/></svg>",
+		},
+		{
+			render: func(p *Painter) ([]byte, error) {
+				_, err := NewVisualMapPainter(p, VisualMapOption{
+					Show: FalseFlag(),
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n</svg>",
+		},
+	}
+	for _, tt := range tests {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		data, err := tt.render(p)
+		assert.Nil(err)
+		assert.Equal(tt.result, string(data))
+	}
+}
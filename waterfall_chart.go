@@ -0,0 +1,348 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+type waterfallChart struct {
+	p   *Painter
+	opt *WaterfallChartOption
+}
+
+// WaterfallData is the data of waterfall chart
+type WaterfallData struct {
+	// The flag of total bar, the value is ignored and
+	// the bar is the running total of previous values
+	IsTotal bool
+}
+
+// isWaterfallTotal returns true if the series data is the total bar of waterfall chart
+func (item *SeriesData) isWaterfallTotal() bool {
+	return item.Waterfall != nil && item.Waterfall.IsTotal
+}
+
+// NewWaterfallSeriesList returns a series list for waterfall chart, the values are
+// the changes of each step, and the bars of total indexes are the running total
+func NewWaterfallSeriesList(values []float64, totalIndexes ...int) SeriesList {
+	data := NewSeriesDataFromValues(values)
+	for _, index := range totalIndexes {
+		if index >= 0 && index < len(data) {
+			data[index].Waterfall = &WaterfallData{
+				IsTotal: true,
+			}
+		}
+	}
+	return SeriesList{
+		{
+			Type: ChartTypeWaterfall,
+			Data: data,
+		},
+	}
+}
+
+type waterfallBar struct {
+	start float64
+	end   float64
+	// The bar is null value
+	isNull bool
+}
+
+// getWaterfallBars returns the start and end value of each bar,
+// the bar starts from the running total of previous values
+func getWaterfallBars(data []SeriesData) []waterfallBar {
+	bars := make([]waterfallBar, len(data))
+	total := 0.0
+	for index, item := range data {
+		if item.isWaterfallTotal() {
+			bars[index] = waterfallBar{
+				start: 0,
+				end:   total,
+			}
+			continue
+		}
+		if item.Value == nullValue {
+			bars[index] = waterfallBar{
+				start:  total,
+				end:    total,
+				isNull: true,
+			}
+			continue
+		}
+		bars[index] = waterfallBar{
+			start: total,
+			end:   total + item.Value,
+		}
+		total += item.Value
+	}
+	return bars
+}
+
+// NewWaterfallChart returns a waterfall chart renderer
+func NewWaterfallChart(p *Painter, opt WaterfallChartOption) *waterfallChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &waterfallChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// 增加为绿色，减少为红色
+var defaultWaterfallIncreaseColor = drawing.Color{
+	R: 71,
+	G: 178,
+	B: 98,
+	A: 255,
+}
+var defaultWaterfallDecreaseColor = drawing.Color{
+	R: 235,
+	G: 84,
+	B: 84,
+	A: 255,
+}
+
+type WaterfallOption struct {
+	// The color of increase bar, default is green
+	IncreaseColor Color
+	// The color of decrease bar, default is red
+	DecreaseColor Color
+}
+
+type WaterfallChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font size
+	Font *truetype.Font
+	// The data series list
+	SeriesList SeriesList
+	// The x axis option
+	XAxis XAxisOption
+	// The padding of waterfall chart
+	Padding Box
+	// The y axis option
+	YAxisOptions []YAxisOption
+	// The option of title
+	Title TitleOption
+	// The legend option
+	Legend LegendOption
+	// The width of bar
+	BarWidth int
+	// The option of waterfall
+	Waterfall WaterfallOption
+}
+
+func (w *waterfallChart) render(result *defaultRenderResult, seriesList SeriesList) (Box, error) {
+	p := w.p
+	opt := w.opt
+	seriesPainter := result.seriesPainter
+
+	xRange := newCategoryRange(w.p, len(opt.XAxis.Data), seriesPainter.Width())
+	x0, x1 := xRange.GetRange(0)
+	width := int(x1 - x0)
+	// 每一块之间的margin
+	margin := 10
+	// 每一个bar之间的margin
+	barMargin := 5
+	if width < 20 {
+		margin = 2
+		barMargin = 2
+	} else if width < 50 {
+		margin = 5
+		barMargin = 3
+	}
+	seriesCount := len(seriesList)
+	barWidth := (width - 2*margin - barMargin*(seriesCount-1)) / seriesCount
+	if opt.BarWidth > 0 && opt.BarWidth < barWidth {
+		barWidth = opt.BarWidth
+		// 重新计算margin
+		margin = (width - seriesCount*barWidth - barMargin*(seriesCount-1)) / 2
+	}
+	if barWidth < 1 {
+		barWidth = 1
+	}
+	theme := opt.Theme
+	increaseColor := opt.Waterfall.IncreaseColor
+	if increaseColor.IsZero() {
+		increaseColor = defaultWaterfallIncreaseColor
+	}
+	decreaseColor := opt.Waterfall.DecreaseColor
+	if decreaseColor.IsZero() {
+		decreaseColor = defaultWaterfallDecreaseColor
+	}
+	divideValues := xRange.AutoDivide()
+	seriesNames := seriesList.Names()
+
+	markPointPainter := NewMarkPointPainter(seriesPainter)
+	markLinePainter := NewMarkLinePainter(seriesPainter)
+	rendererList := []Renderer{
+		markPointPainter,
+		markLinePainter,
+	}
+	for index := range seriesList {
+		series := seriesList[index]
+		yRange := result.axisRanges[series.AxisIndex]
+		seriesColor := theme.GetSeriesColor(series.index)
+		var labelPainter *SeriesLabelPainter
+		if series.Label.Show {
+			labelPainter = NewSeriesLabelPainter(SeriesLabelPainterParams{
+				P:           seriesPainter,
+				SeriesNames: seriesNames,
+				Label:       series.Label,
+				Theme:       opt.Theme,
+				Font:        opt.Font,
+			})
+			rendererList = append(rendererList, labelPainter)
+		}
+
+		bars := getWaterfallBars(series.Data)
+		points := make([]Point, len(series.Data))
+		prevRight := -1
+		prevY := 0
+		for j, item := range series.Data {
+			if j >= xRange.divideCount {
+				continue
+			}
+			bar := bars[j]
+			if bar.isNull {
+				prevRight = -1
+				continue
+			}
+			x := divideValues[j] + margin
+			if index != 0 {
+				x += index * (barWidth + barMargin)
+			}
+			startY := yRange.getRestHeight(bar.start)
+			endY := yRange.getRestHeight(bar.end)
+			top := startY
+			bottom := endY
+			if top > bottom {
+				top, bottom = bottom, top
+			}
+			// 无变化时至少展示1px
+			if top == bottom {
+				bottom++
+			}
+
+			// 连接线由前一柱的结束值连接至当前柱
+			if prevRight >= 0 {
+				seriesPainter.OverrideDrawingStyle(Style{
+					StrokeColor: theme.GetAxisStrokeColor(),
+					StrokeWidth: 1,
+				}).LineStroke([]Point{
+					{
+						X: prevRight,
+						Y: prevY,
+					},
+					{
+						X: x,
+						Y: prevY,
+					},
+				})
+			}
+
+			// 汇总使用系列颜色，增加与减少分别使用对应的颜色
+			color := seriesColor
+			value := bar.end - bar.start
+			if !item.isWaterfallTotal() {
+				color = increaseColor
+				if value < 0 {
+					color = decreaseColor
+				}
+			}
+			if !item.Style.FillColor.IsZero() {
+				color = item.Style.FillColor
+			}
+			seriesPainter.OverrideDrawingStyle(Style{
+				FillColor: color,
+			}).Rect(Box{
+				Top:    top,
+				Left:   x,
+				Right:  x + barWidth,
+				Bottom: bottom,
+			})
+			prevRight = x + barWidth
+			prevY = endY
+			points[j] = Point{
+				X: x + barWidth>>1,
+				Y: endY,
+			}
+			if labelPainter == nil {
+				continue
+			}
+			labelPainter.Add(LabelValue{
+				Index:     index,
+				Value:     value,
+				X:         x + barWidth>>1,
+				Y:         top,
+				FontColor: series.Label.Color,
+				Offset:    series.Label.Offset,
+				FontSize:  series.Label.FontSize,
+			})
+		}
+
+		markPointPainter.Add(markPointRenderOption{
+			FillColor: seriesColor,
+			Font:      opt.Font,
+			Series:    series,
+			Points:    points,
+		})
+		markLinePainter.Add(markLineRenderOption{
+			FillColor:   seriesColor,
+			FontColor:   opt.Theme.GetTextColor(),
+			StrokeColor: seriesColor,
+			Font:        opt.Font,
+			Series:      series,
+			Range:       yRange,
+		})
+	}
+	// 最大、最小的mark point
+	err := doRender(rendererList...)
+	if err != nil {
+		return BoxZero, err
+	}
+
+	return p.box, nil
+}
+
+func (w *waterfallChart) Render() (Box, error) {
+	p := w.p
+	opt := w.opt
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		Theme:        opt.Theme,
+		Padding:      opt.Padding,
+		SeriesList:   opt.SeriesList,
+		XAxis:        opt.XAxis,
+		YAxisOptions: opt.YAxisOptions,
+		TitleOption:  opt.Title,
+		LegendOption: opt.Legend,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	seriesList := opt.SeriesList.Filter(ChartTypeWaterfall)
+	return w.render(renderResult, seriesList)
+}
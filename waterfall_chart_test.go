@@ -0,0 +1,164 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWaterfallSeriesList(t *testing.T) {
+	assert := assert.New(t)
+
+	seriesList := NewWaterfallSeriesList([]float64{
+		10,
+		-3,
+		0,
+	}, 2, 5)
+	assert.Equal(1, len(seriesList))
+	assert.Equal(ChartTypeWaterfall, seriesList[0].Type)
+	assert.Equal([]SeriesData{
+		{
+			Value: 10,
+		},
+		{
+			Value: -3,
+		},
+		{
+			Value: 0,
+			Waterfall: &WaterfallData{
+				IsTotal: true,
+			},
+		},
+	}, seriesList[0].Data)
+}
+
+func TestGetWaterfallBars(t *testing.T) {
+	assert := assert.New(t)
+
+	seriesList := NewWaterfallSeriesList([]float64{
+		10,
+		-3,
+		0,
+		nullValue,
+		-12,
+	}, 2)
+	assert.Equal([]waterfallBar{
+		{
+			start: 0,
+			end:   10,
+		},
+		{
+			start: 10,
+			end:   7,
+		},
+		{
+			start: 0,
+			end:   7,
+		},
+		{
+			start:  7,
+			end:    7,
+			isNull: true,
+		},
+		{
+			start: 7,
+			end:   -5,
+		},
+	}, getWaterfallBars(seriesList[0].Data))
+
+	max, min := seriesList.GetMaxMin(0)
+	assert.Equal(10.0, max)
+	assert.Equal(-5.0, min)
+}
+
+func TestWaterfallChart(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		render func(*Painter) ([]byte, error)
+		result string
+	}{
+		{
+			render: func(p *Painter) ([]byte, error) {
+				seriesList := NewWaterfallSeriesList([]float64{
+					420,
+					180,
+					-90,
+					-60,
+					0,
+					120,
+					-200,
+					0,
+				}, 4, 7)
+				seriesList[0].Label.Show = true
+				seriesList[0].Data[7].Style.FillColor = parseColor("#bbb")
+				_, err := NewWaterfallChart(p, WaterfallChartOption{
+					Title: TitleOption{
+						Text: "Profit",
+					},
+					Padding: Box{
+						Top:    10,
+						Right:  10,
+						Bottom: 10,
+						Left:   10,
+					},
+					SeriesList: seriesList,
+					XAxis: NewXAxisOption([]string{
+						"Revenue",
+						"Services",
+						"COGS",
+						"Opex",
+						"Gross",
+						"Other",
+						"Tax",
+						"Net",
+					}),
+					YAxisOptions: []YAxisOption{
+						{
+							Min: NewFloatPoint(0),
+						},
+					},
+				}).Render()
+				if err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+			result: "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" width=\"600\" height=\"400\">\\n<path  d=\"M 0 0\nL 600 0\nL 600 400\nL 0 400\nL 0 0\" style=\"stroke-width:0;stroke:none;fill:rgba(255,255,255,1.0)\"/><text x=\"10\" y=\"25\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Profit</text><text x=\"10\" y=\"52\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">600</text><text x=\"10\" y=\"104\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">500</text><text x=\"10\" y=\"157\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">400</text><text x=\"10\" y=\"209\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">300</text><text x=\"10\" y=\"262\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">200</text><text x=\"10\" y=\"314\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">100</text><text x=\"28\" y=\"367\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">0</text><path  d=\"M 47 45\nL 590 45\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 97\nL 590 97\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 150\nL 590 150\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 202\nL 590 202\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 255\nL 590 255\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 307\nL 590 307\" style=\"stroke-width:1;stroke:rgba(224,230,242,1.0);fill:none\"/><path  d=\"M 47 365\nL 47 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 182 365\nL 182 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 318 365\nL 318 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 454 365\nL 454 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 590 365\nL 590 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 47 360\nL 590 360\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><text x=\"119\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Services</text><text x=\"266\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Opex</text><text x=\"401\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Other</text><text x=\"544\" y=\"385\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:15.3px;font-family:'Roboto Medium',sans-serif\">Net</text><path  d=\"M 57 140\nL 104 140\nL 104 360\nL 57 360\nL 57 140\" style=\"stroke-width:0;stroke:none;fill:rgba(71,178,98,1.0)\"/><path  d=\"M 104 140\nL 124 140\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 124 45\nL 171 45\nL 171 140\nL 124 140\nL 124 45\" style=\"stroke-width:0;stroke:none;fill:rgba(71,178,98,1.0)\"/><path  d=\"M 171 45\nL 192 45\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 192 45\nL 239 45\nL 239 93\nL 192 93\nL 192 45\" style=\"stroke-width:0;stroke:none;fill:rgba(235,84,84,1.0)\"/><path  d=\"M 239 93\nL 260 93\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 260 93\nL 307 93\nL 307 124\nL 260 124\nL 260 93\" style=\"stroke-width:0;stroke:none;fill:rgba(235,84,84,1.0)\"/><path  d=\"M 307 124\nL 328 124\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 328 124\nL 375 124\nL 375 360\nL 328 360\nL 328 124\" style=\"stroke-width:0;stroke:none;fill:rgba(84,112,198,1.0)\"/><path  d=\"M 375 124\nL 396 124\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 396 61\nL 443 61\nL 443 124\nL 396 124\nL 396 61\" style=\"stroke-width:0;stroke:none;fill:rgba(71,178,98,1.0)\"/><path  d=\"M 443 61\nL 464 61\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 464 61\nL 511 61\nL 511 166\nL 464 166\nL 464 61\" style=\"stroke-width:0;stroke:none;fill:rgba(235,84,84,1.0)\"/><path  d=\"M 511 166\nL 532 166\" style=\"stroke-width:1;stroke:rgba(110,112,121,1.0);fill:none\"/><path  d=\"M 532 166\nL 579 166\nL 579 360\nL 532 360\nL 532 166\" style=\"stroke-width:0;stroke:none;fill:rgba(187,187,187,1.0)\"/><text x=\"68\" y=\"135\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">420</text><text x=\"135\" y=\"40\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">180</text><text x=\"205\" y=\"40\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">-90</text><text x=\"273\" y=\"88\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">-60</text><text x=\"339\" y=\"119\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">450</text><text x=\"407\" y=\"56\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">120</text><text x=\"471\" y=\"56\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">-200</text><text x=\"543\" y=\"161\" style=\"stroke-width:0;stroke:none;fill:rgba(70,70,70,1.0);font-size:12.8px;font-family:'Roboto Medium',sans-serif\">370</text></svg>",
+		},
+	}
+
+	for _, tt := range tests {
+		p, err := NewPainter(PainterOptions{
+			Type:   ChartOutputSVG,
+			Width:  600,
+			Height: 400,
+		}, PainterThemeOption(defaultTheme))
+		assert.Nil(err)
+		data, err := tt.render(p)
+		assert.Nil(err)
+		assert.Equal(tt.result, string(data))
+	}
+}
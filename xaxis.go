@@ -23,6 +23,8 @@
 package charts
 
 import (
+	"time"
+
 	"github.com/golang/freetype/truetype"
 )
 
@@ -54,7 +56,23 @@ type XAxisOption struct {
 	FirstAxis int
 	// The offset of label
 	LabelOffset Box
+	// The minimun value of axis, it's only for value axis
+	Min *float64
+	// The maximum value of axis, it's only for value axis
+	Max *float64
+	// Formatter for x axis text value, e.g.: "{value}%"
+	Formatter string
+	// The type of axis, it can be "category" or "time", default value is "category".
+	// The time axis positions line and scatter series by the time of series data
+	Type string
+	// The location of time axis, default is the location of series data's time
+	Location *time.Location
+	// The layout of time axis label, e.g.: "2006-01-02".
+	// It is chosen by the interval of ticks if it's empty
+	TimeLayout  string
 	isValueAxis bool
+	// The positions of ticks
+	positions []int
 }
 
 const defaultXAxisHeight = 30
@@ -90,11 +108,15 @@ func (opt *XAxisOption) ToAxisOption() AxisOption {
 		TextRotation:   opt.TextRotation,
 		LabelOffset:    opt.LabelOffset,
 		FirstAxis:      opt.FirstAxis,
+		Formatter:      opt.Formatter,
+		Positions:      opt.positions,
 	}
 	if opt.isValueAxis {
 		axisOpt.SplitLineShow = true
 		axisOpt.StrokeWidth = -1
 		axisOpt.BoundaryGap = FalseFlag()
+		// 数值轴的分隔数量已用于计算刻度
+		axisOpt.SplitNumber = 0
 	}
 	return axisOpt
 }
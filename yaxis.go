@@ -52,6 +52,24 @@ type YAxisOption struct {
 	isCategoryAxis bool
 	// The flag for show axis split line, set this to true will show axis split line
 	SplitLineShow *bool
+	// The type of axis, it can be "value" or "log", default value is "value".
+	// The value of log axis should be > 0
+	Type string
+	// The base of log axis, default value is 10
+	LogBase float64
+}
+
+const defaultLogBase = 10.0
+
+// getLogBase returns the base of log axis, it returns 0 if it's not log axis
+func (opt *YAxisOption) getLogBase() float64 {
+	if opt.Type != AxisTypeLog {
+		return 0
+	}
+	if opt.LogBase <= 0 || opt.LogBase == 1 {
+		return defaultLogBase
+	}
+	return opt.LogBase
 }
 
 // NewYAxisOptions returns a y axis option